@@ -14,8 +14,12 @@ import (
 // This method differs from gohcl.DecodeBody in several ways:
 //
 // - Does not support decoding to map, cty.Value, hcl.Body, hcl.Expression.
-// - Does not support `body` and `remain` tags.
-//   - Extraneous attributes are always ignored.
+// - Extraneous attributes are always ignored, even if there is no `remain` field.
+// - `body` and `remain` tags are applied to hclext.BodyContent instead of hcl.Body.
+//   - A `body` field can be hclext.BodyContent or *hclext.BodyContent.
+//   - A `remain` field can be hclext.Attributes, hclext.BodyContent, or *hclext.BodyContent.
+//
+// If the `remain` field is hclext.Attributes, the rest of blocks are ignored.
 //
 // @see https://github.com/hashicorp/hcl/blob/v2.11.1/gohcl/decode.go
 func DecodeBody(body *BodyContent, ctx *hcl.EvalContext, val interface{}) hcl.Diagnostics {
@@ -27,6 +31,22 @@ func DecodeBody(body *BodyContent, ctx *hcl.EvalContext, val interface{}) hcl.Di
 	return decodeBody(body, ctx, rv.Elem())
 }
 
+// PartialDecodeBody is similar to DecodeBody, but it returns the rest of the body content
+// that is not decoded into the struct fields, like hclext.PartialContent.
+// Note that the rest is returned only for the top-level body.
+func PartialDecodeBody(body *BodyContent, ctx *hcl.EvalContext, val interface{}) (*BodyContent, hcl.Diagnostics) {
+	rv := reflect.ValueOf(val)
+	if rv.Kind() != reflect.Ptr {
+		panic(fmt.Sprintf("target value must be a pointer, not %s", rv.Type().String()))
+	}
+
+	diags := decodeBody(body, ctx, rv.Elem())
+	if body == nil {
+		return &BodyContent{Attributes: Attributes{}, Blocks: Blocks{}}, diags
+	}
+	return remainContent(body, getFieldTags(rv.Elem().Type())), diags
+}
+
 func decodeBody(body *BodyContent, ctx *hcl.EvalContext, val reflect.Value) hcl.Diagnostics {
 	if body == nil {
 		return nil
@@ -162,9 +182,44 @@ func decodeBodyToStruct(body *BodyContent, ctx *hcl.EvalContext, val reflect.Val
 		}
 	}
 
+	if tags.Body != nil {
+		setBodyContent(val.Field(*tags.Body), body)
+	}
+	if tags.Remain != nil {
+		setBodyContent(val.Field(*tags.Remain), remainContent(body, tags))
+	}
+
 	return diags
 }
 
+// remainContent returns the attributes and blocks that are not decoded into the struct fields.
+func remainContent(body *BodyContent, tags *fieldTags) *BodyContent {
+	ret := &BodyContent{Attributes: Attributes{}, Blocks: Blocks{}}
+	for name, attr := range body.Attributes {
+		if _, exists := tags.Attributes[name]; !exists {
+			ret.Attributes[name] = attr
+		}
+	}
+	for _, block := range body.Blocks {
+		if _, exists := tags.Blocks[block.Type]; !exists {
+			ret.Blocks = append(ret.Blocks, block)
+		}
+	}
+	return ret
+}
+
+// setBodyContent sets the body content to the field of `body` or `remain` tag.
+func setBodyContent(field reflect.Value, body *BodyContent) {
+	switch field.Type() {
+	case attributesType:
+		field.Set(reflect.ValueOf(body.Attributes))
+	case bodyContentType:
+		field.Set(reflect.ValueOf(*body))
+	case bodyContentPtrType:
+		field.Set(reflect.ValueOf(body))
+	}
+}
+
 func decodeBlockToValue(block *Block, ctx *hcl.EvalContext, v reflect.Value) hcl.Diagnostics {
 	diags := decodeBody(block.Body, ctx, v)
 
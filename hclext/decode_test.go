@@ -47,6 +47,22 @@ func TestDecodeBody(t *testing.T) {
 		Nested []withTwoAttributes `hclext:"nested,block"`
 	}
 
+	type withRemainAttributes struct {
+		Name   string     `hclext:"name"`
+		Remain Attributes `hclext:",remain"`
+	}
+
+	type withRemainBody struct {
+		Name   string             `hclext:"name"`
+		Nested *withTwoAttributes `hclext:"nested,block"`
+		Remain *BodyContent       `hclext:",remain"`
+	}
+
+	type withBody struct {
+		Name string      `hclext:"name"`
+		Body BodyContent `hclext:",body"`
+	}
+
 	tests := []struct {
 		Name      string
 		Body      *BodyContent
@@ -591,6 +607,59 @@ func TestDecodeBody(t *testing.T) {
 			}),
 			DiagCount: 0,
 		},
+		{
+			Name: "remain attributes",
+			Body: &BodyContent{
+				Attributes: Attributes{
+					"name": &Attribute{Name: "name", Expr: parseExpr(`"Ermintrude"`)},
+					"age":  &Attribute{Name: "age", Expr: parseExpr(`23`)},
+				},
+				Blocks: Blocks{&Block{Type: "nested", Body: &BodyContent{}}},
+			},
+			Target: makeInstantiateType(withRemainAttributes{}),
+			Check: func(v interface{}) bool {
+				got := v.(withRemainAttributes)
+				_, exists := got.Remain["age"]
+				return got.Name == "Ermintrude" && len(got.Remain) == 1 && exists
+			},
+			DiagCount: 0,
+		},
+		{
+			Name: "remain body",
+			Body: &BodyContent{
+				Attributes: Attributes{
+					"name": &Attribute{Name: "name", Expr: parseExpr(`"Ermintrude"`)},
+					"age":  &Attribute{Name: "age", Expr: parseExpr(`23`)},
+				},
+				Blocks: Blocks{
+					&Block{Type: "nested", Body: &BodyContent{}},
+					&Block{Type: "extra", Body: &BodyContent{}},
+				},
+			},
+			Target: makeInstantiateType(withRemainBody{}),
+			Check: func(v interface{}) bool {
+				got := v.(withRemainBody)
+				_, exists := got.Remain.Attributes["age"]
+				return got.Nested != nil && len(got.Remain.Attributes) == 1 && exists &&
+					len(got.Remain.Blocks) == 1 && got.Remain.Blocks[0].Type == "extra"
+			},
+			DiagCount: 0,
+		},
+		{
+			Name: "body",
+			Body: &BodyContent{
+				Attributes: Attributes{
+					"name": &Attribute{Name: "name", Expr: parseExpr(`"Ermintrude"`)},
+					"age":  &Attribute{Name: "age", Expr: parseExpr(`23`)},
+				},
+			},
+			Target: makeInstantiateType(withBody{}),
+			Check: func(v interface{}) bool {
+				got := v.(withBody)
+				return got.Name == "Ermintrude" && len(got.Body.Attributes) == 2
+			},
+			DiagCount: 0,
+		},
 	}
 
 	for _, test := range tests {
@@ -611,3 +680,90 @@ func TestDecodeBody(t *testing.T) {
 		})
 	}
 }
+
+func TestPartialDecodeBody(t *testing.T) {
+	parseExpr := func(src string) hcl.Expression {
+		expr, diags := hclsyntax.ParseExpression([]byte(src), "", hcl.InitialPos)
+		if diags.HasErrors() {
+			panic(diags)
+		}
+		return expr
+	}
+
+	type target struct {
+		Name   string `hclext:"name"`
+		Nested *struct {
+			A string `hclext:"a,optional"`
+		} `hclext:"nested,block"`
+	}
+
+	body := &BodyContent{
+		Attributes: Attributes{
+			"name": &Attribute{Name: "name", Expr: parseExpr(`"Ermintrude"`)},
+			"age":  &Attribute{Name: "age", Expr: parseExpr(`23`)},
+		},
+		Blocks: Blocks{
+			&Block{Type: "nested", Body: &BodyContent{}},
+			&Block{Type: "extra", Labels: []string{"foo"}, Body: &BodyContent{}},
+		},
+	}
+
+	var got target
+	remain, diags := PartialDecodeBody(body, nil, &got)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+
+	if got.Name != "Ermintrude" || got.Nested == nil {
+		t.Errorf("unexpected decoded value: %#v", got)
+	}
+	if _, exists := remain.Attributes["age"]; !exists || len(remain.Attributes) != 1 {
+		t.Errorf("unexpected remain attributes: %#v", remain.Attributes)
+	}
+	if diff := cmp.Diff(Blocks{body.Blocks[1]}, remain.Blocks); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestDecodeBody_remainWithImpliedSchema(t *testing.T) {
+	src := `
+name = "Ermintrude"
+age  = 23
+
+nested {
+  a = "foo"
+}
+
+extra "foo" {
+  b = "bar"
+}
+`
+	file, diags := hclsyntax.ParseConfig([]byte(src), "test.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+
+	type target struct {
+		Name   string `hclext:"name"`
+		Nested *struct {
+			A string `hclext:"a"`
+		} `hclext:"nested,block"`
+		Remain *BodyContent `hclext:",remain"`
+	}
+
+	content, diags := Content(file.Body, ImpliedBodySchema(target{}))
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	var got target
+	if diags := DecodeBody(content, nil, &got); diags.HasErrors() {
+		t.Fatal(diags)
+	}
+
+	if got.Name != "Ermintrude" || got.Nested == nil || got.Nested.A != "foo" {
+		t.Errorf("unexpected decoded value: %#v", got)
+	}
+	if diff := cmp.Diff(`age extra[foo]{b}`, dumpContent(got.Remain)); diff != "" {
+		t.Error(diff)
+	}
+}
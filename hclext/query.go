@@ -0,0 +1,365 @@
+package hclext
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+// Query is a path to select attributes and blocks from BodyContent.
+//
+// A query consists of steps separated by dots. Each step is a block type or an attribute name,
+// and a block type is followed by steps that match its labels. For example:
+//
+//	resource.aws_instance.*.ebs_block_device[*].volume_size
+//
+// This selects "volume_size" attributes in all "ebs_block_device" blocks of all "aws_instance" resources.
+// The syntax is as follows:
+//
+//   - "*" matches any block type, label, or attribute name.
+//   - "[*]" after a block type matches all blocks of the type. This is the same as no index.
+//   - "[N]" after a block type matches only the N-th block of the type in the parent body.
+//   - The last step is an attribute name unless it matches a label or has an index.
+//     Use "[*]" to select blocks instead of attributes, e.g. "resource.aws_instance.*.lifecycle[*]".
+//   - If there are fewer steps than labels, the remaining labels match anything.
+type Query struct {
+	path  string
+	steps []queryStep
+}
+
+type queryStep struct {
+	name string
+	// index is the position of the block in the parent body. -1 means all blocks.
+	index int
+	// indexed is true if the step has an index, including "[*]".
+	indexed bool
+}
+
+func (s queryStep) match(name string) bool {
+	return s.name == "*" || s.name == name
+}
+
+// ParseQuery parses the given query path.
+func ParseQuery(path string) (*Query, error) {
+	if path == "" {
+		return nil, fmt.Errorf("query must not be empty")
+	}
+
+	segments := strings.Split(path, ".")
+	steps := make([]queryStep, len(segments))
+	for i, segment := range segments {
+		step := queryStep{name: segment, index: -1}
+
+		if open := strings.IndexByte(segment, '['); open >= 0 {
+			if !strings.HasSuffix(segment, "]") {
+				return nil, fmt.Errorf(`invalid query "%s": unclosed index in "%s"`, path, segment)
+			}
+			step.name = segment[:open]
+			step.indexed = true
+
+			if index := segment[open+1 : len(segment)-1]; index != "*" {
+				n, err := strconv.Atoi(index)
+				if err != nil || n < 0 {
+					return nil, fmt.Errorf(`invalid query "%s": index must be "*" or a non-negative integer, but got "%s"`, path, index)
+				}
+				step.index = n
+			}
+		}
+		if step.name == "" || strings.ContainsAny(step.name, "[]") {
+			return nil, fmt.Errorf(`invalid query "%s": invalid step "%s"`, path, segment)
+		}
+		steps[i] = step
+	}
+
+	return &Query{path: path, steps: steps}, nil
+}
+
+// MustParseQuery is similar to ParseQuery, but it panics if the query is invalid.
+// This is useful for queries defined as package-level variables.
+func MustParseQuery(path string) *Query {
+	query, err := ParseQuery(path)
+	if err != nil {
+		panic(err)
+	}
+	return query
+}
+
+// String returns the query path.
+func (q *Query) String() string {
+	return q.path
+}
+
+// QueryResult is an attribute or a block selected by a query.
+// Either Attribute or Block is set.
+type QueryResult struct {
+	Attribute *Attribute
+	Block     *Block
+
+	// Parents are the blocks enclosing the attribute or block, from the outermost.
+	Parents Blocks
+}
+
+// Range returns the range of the attribute, or the definition range of the block.
+func (r *QueryResult) Range() hcl.Range {
+	if r.Attribute != nil {
+		return r.Attribute.Range
+	}
+	return r.Block.DefRange
+}
+
+// Eval selects attributes and blocks matching the query from the given body content.
+// Results are returned in the order of blocks, and attributes in the same body are sorted by name.
+func (q *Query) Eval(body *BodyContent) []*QueryResult {
+	return evalQuery(body, q.steps, Blocks{})
+}
+
+func evalQuery(body *BodyContent, steps []queryStep, parents Blocks) []*QueryResult {
+	ret := []*QueryResult{}
+	if body == nil {
+		return ret
+	}
+	step := steps[0]
+
+	// The last step without an index is an attribute name.
+	if len(steps) == 1 && !step.indexed {
+		names := make([]string, 0, len(body.Attributes))
+		for name := range body.Attributes {
+			if step.match(name) {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			ret = append(ret, &QueryResult{Attribute: body.Attributes[name], Parents: parents})
+		}
+		return ret
+	}
+
+	positions := map[string]int{}
+	for _, block := range body.Blocks {
+		pos := positions[block.Type]
+		positions[block.Type]++
+
+		if !step.match(block.Type) || (step.index >= 0 && step.index != pos) {
+			continue
+		}
+
+		rest := steps[1:]
+		matched := true
+		for _, label := range block.Labels {
+			if len(rest) == 0 {
+				break
+			}
+			if !rest[0].match(label) {
+				matched = false
+				break
+			}
+			rest = rest[1:]
+		}
+		if !matched {
+			continue
+		}
+
+		if len(rest) == 0 {
+			ret = append(ret, &QueryResult{Block: block, Parents: parents})
+			continue
+		}
+		ret = append(ret, evalQuery(block.Body, rest, append(parents[:len(parents):len(parents)], block))...)
+	}
+	return ret
+}
+
+// Query selects attributes and blocks matching the given query path.
+// See hclext.Query for the syntax.
+func (b *BodyContent) Query(path string) ([]*QueryResult, error) {
+	query, err := ParseQuery(path)
+	if err != nil {
+		return nil, err
+	}
+	return query.Eval(b), nil
+}
+
+// topLevelBlockLabels is the number of labels of top-level blocks in Terraform configurations.
+// Other top-level blocks are assumed to have no labels.
+var topLevelBlockLabels = map[string]int{
+	"resource":  2,
+	"data":      2,
+	"ephemeral": 2,
+	"module":    1,
+	"provider":  1,
+	"variable":  1,
+	"output":    1,
+	"check":     1,
+}
+
+// nestedBlockLabels is the number of labels of nested blocks defined by Terraform, keyed by the parent block type.
+// Other nested blocks, such as blocks defined by providers, are assumed to have no labels.
+// "dynamic" blocks are not listed here because they have a label in any nested body.
+var nestedBlockLabels = map[string]map[string]int{
+	"resource":  {"provisioner": 1},
+	"removed":   {"provisioner": 1},
+	"check":     {"data": 2},
+	"terraform": {"backend": 1, "provider_meta": 1},
+}
+
+// blockLabels returns the number of labels of the block type in the parent block type.
+// The parent is empty for top-level blocks.
+func blockLabels(parent string, blockType string) int {
+	if parent == "" {
+		return topLevelBlockLabels[blockType]
+	}
+	if blockType == "dynamic" {
+		return 1
+	}
+	return nestedBlockLabels[parent][blockType]
+}
+
+// QuerySchema derives the minimal schema needed to evaluate the given query paths
+// against the content of a Terraform module. The schema can be passed to GetModuleContent.
+//
+// The number of labels is determined by the block type, like "resource" blocks have 2 labels.
+// This also applies to nested blocks defined by Terraform, like "provisioner" and "dynamic" blocks.
+// The "*" attribute name retrieves all attributes using SchemaJustAttributesMode,
+// so it cannot be used with blocks in the same body. The "*" block type retrieves
+// all blocks using SchemaRecursiveMode up to the depth the query can reach.
+func QuerySchema(paths ...string) (*BodySchema, error) {
+	schema := &BodySchema{}
+	for _, path := range paths {
+		query, err := ParseQuery(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := mergeQuerySchema(schema, query.steps, ""); err != nil {
+			return nil, fmt.Errorf(`invalid query "%s": %w`, path, err)
+		}
+	}
+	return schema, nil
+}
+
+// mergeQuerySchema adds the schema for the query steps to the given schema.
+// The parent is the type of the block that has the body, or empty for the top level.
+func mergeQuerySchema(schema *BodySchema, steps []queryStep, parent string) error {
+	step := steps[0]
+
+	// The recursive schema already retrieves all attributes, so it only needs to be deep enough.
+	if schema.Mode == SchemaRecursiveMode {
+		required := &BodySchema{}
+		if err := mergeQuerySchema(required, steps, parent); err != nil {
+			return err
+		}
+		return toRecursiveSchema(schema, nestingDepth(required))
+	}
+
+	if len(steps) == 1 && !step.indexed {
+		if step.name == "*" {
+			if len(schema.Blocks) > 0 {
+				return fmt.Errorf("cannot retrieve all attributes in a body with blocks")
+			}
+			schema.Mode = SchemaJustAttributesMode
+			schema.Attributes = nil
+			return nil
+		}
+		if schema.Mode == SchemaJustAttributesMode {
+			return nil
+		}
+		for _, attr := range schema.Attributes {
+			if attr.Name == step.name {
+				return nil
+			}
+		}
+		schema.Attributes = append(schema.Attributes, AttributeSchema{Name: step.name})
+		return nil
+	}
+
+	if schema.Mode == SchemaJustAttributesMode {
+		return fmt.Errorf("cannot retrieve blocks in a body with all attributes")
+	}
+
+	if step.name == "*" {
+		// Since each block consumes at least one step, the number of steps is enough for the depth.
+		depth := len(steps)
+		if !steps[len(steps)-1].indexed {
+			depth--
+		}
+		return toRecursiveSchema(schema, depth)
+	}
+
+	labels := blockLabels(parent, step.name)
+
+	var block *BlockSchema
+	for i := range schema.Blocks {
+		if schema.Blocks[i].Type == step.name {
+			block = &schema.Blocks[i]
+			break
+		}
+	}
+	if block == nil {
+		schema.Blocks = append(schema.Blocks, BlockSchema{Type: step.name, LabelNames: labelNames(labels), Body: &BodySchema{}})
+		block = &schema.Blocks[len(schema.Blocks)-1]
+	}
+
+	rest := steps[1:]
+	if len(rest) <= labels {
+		return nil
+	}
+	return mergeQuerySchema(block.Body, rest[labels:], step.name)
+}
+
+// labelNames returns placeholder names for the given number of labels.
+func labelNames(n int) []string {
+	names := make([]string, n)
+	for i := range names {
+		names[i] = fmt.Sprintf("label%d", i)
+	}
+	return names
+}
+
+// toRecursiveSchema converts the schema to SchemaRecursiveMode that retrieves blocks
+// at least to the given depth, in addition to blocks already declared. -1 means no limit.
+func toRecursiveSchema(schema *BodySchema, depth int) error {
+	if schema.Mode == SchemaJustAttributesMode {
+		return fmt.Errorf("cannot retrieve blocks in a body with all attributes")
+	}
+
+	current := nestingDepth(schema)
+	if current < 0 || depth < 0 {
+		depth = 0
+	} else if current > depth {
+		depth = current
+	}
+
+	schema.Mode = SchemaRecursiveMode
+	schema.Attributes = nil
+	schema.Blocks = nil
+	schema.Depth = depth
+	return nil
+}
+
+// nestingDepth returns the maximum nesting level of blocks retrieved by the schema. -1 means no limit.
+func nestingDepth(schema *BodySchema) int {
+	if schema == nil {
+		return 0
+	}
+
+	depth := 0
+	if schema.Mode == SchemaRecursiveMode {
+		if schema.Depth == 0 {
+			return -1
+		}
+		depth = schema.Depth
+	}
+	for _, block := range schema.Blocks {
+		child := nestingDepth(block.Body)
+		if child < 0 {
+			return -1
+		}
+		if child+1 > depth {
+			depth = child + 1
+		}
+	}
+	return depth
+}
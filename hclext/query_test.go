@@ -0,0 +1,515 @@
+package hclext
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestQuery(t *testing.T) {
+	src := `
+resource "aws_instance" "web" {
+  instance_type = "t2.micro"
+
+  ebs_block_device {
+    volume_size = 10
+  }
+  ebs_block_device {
+    volume_size = 20
+  }
+}
+
+resource "aws_instance" "db" {
+  instance_type = "m5.large"
+
+  ebs_block_device {
+    volume_size = 30
+  }
+}
+
+resource "aws_s3_bucket" "main" {
+  bucket = "foo"
+}
+
+locals {
+  foo = 1
+  bar = 2
+}
+`
+	paths := []string{
+		"resource.aws_instance.*.ebs_block_device[*].volume_size",
+		"resource.aws_instance.*.instance_type",
+		"resource.aws_s3_bucket",
+		"locals.*",
+	}
+	schema, err := QuerySchema(paths...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, diags := hclsyntax.ParseConfig([]byte(src), "main.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	content, diags := PartialContent(file.Body, schema)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "attributes in nested blocks",
+			query: "resource.aws_instance.*.ebs_block_device[*].volume_size",
+			want: []string{
+				"resource.aws_instance.web.ebs_block_device.volume_size:6",
+				"resource.aws_instance.web.ebs_block_device.volume_size:9",
+				"resource.aws_instance.db.ebs_block_device.volume_size:17",
+			},
+		},
+		{
+			name:  "without index",
+			query: "resource.aws_instance.*.ebs_block_device.volume_size",
+			want: []string{
+				"resource.aws_instance.web.ebs_block_device.volume_size:6",
+				"resource.aws_instance.web.ebs_block_device.volume_size:9",
+				"resource.aws_instance.db.ebs_block_device.volume_size:17",
+			},
+		},
+		{
+			name:  "index",
+			query: "resource.aws_instance.web.ebs_block_device[1].volume_size",
+			want: []string{
+				"resource.aws_instance.web.ebs_block_device.volume_size:9",
+			},
+		},
+		{
+			name:  "label",
+			query: "resource.aws_instance.db.instance_type",
+			want:  []string{"resource.aws_instance.db.instance_type:14"},
+		},
+		{
+			name:  "blocks",
+			query: "resource.aws_instance.web.ebs_block_device[*]",
+			want: []string{
+				"resource.aws_instance.web.ebs_block_device:5",
+				"resource.aws_instance.web.ebs_block_device:8",
+			},
+		},
+		{
+			name:  "partial labels",
+			query: "resource.aws_instance",
+			want: []string{
+				"resource.aws_instance.web:2",
+				"resource.aws_instance.db:13",
+			},
+		},
+		{
+			name:  "wildcard block type",
+			query: "*.*.main",
+			want:  []string{"resource.aws_s3_bucket.main:21"},
+		},
+		{
+			name:  "wildcard attribute",
+			query: "locals.*",
+			want:  []string{"locals.bar:27", "locals.foo:26"},
+		},
+		{
+			name:  "no match",
+			query: "resource.aws_instance.*.ami",
+			want:  []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results, err := content.Query(test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := make([]string, len(results))
+			for i, result := range results {
+				steps := []string{}
+				for _, parent := range result.Parents {
+					steps = append(append(steps, parent.Type), parent.Labels...)
+				}
+				if result.Attribute != nil {
+					steps = append(steps, result.Attribute.Name)
+				} else {
+					steps = append(append(steps, result.Block.Type), result.Block.Labels...)
+				}
+				got[i] = fmt.Sprintf("%s:%d", strings.Join(steps, "."), result.Range().Start.Line)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestQuery_nestedBlockLabels(t *testing.T) {
+	src := `
+resource "null_resource" "main" {
+  provisioner "local-exec" {
+    command = "echo foo"
+  }
+
+  dynamic "setting" {
+    for_each = var.settings
+    content {
+      name = setting.value
+    }
+  }
+}
+
+terraform {
+  backend "s3" {
+    bucket = "foo"
+  }
+}
+`
+	paths := []string{
+		"resource.null_resource.*.provisioner.local-exec.command",
+		"resource.null_resource.*.dynamic.setting.content[*].name",
+		"terraform.backend.s3.bucket",
+	}
+	schema, err := QuerySchema(paths...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, diags := hclsyntax.ParseConfig([]byte(src), "main.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	content, diags := PartialContent(file.Body, schema)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+
+	for _, path := range paths {
+		results, err := content.Query(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(results) != 1 {
+			t.Errorf(`got %d results for "%s", but 1 result is expected`, len(results), path)
+		}
+	}
+}
+
+func TestParseQuery_errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "empty",
+			query: "",
+			want:  "query must not be empty",
+		},
+		{
+			name:  "empty step",
+			query: "resource..foo",
+			want:  `invalid query "resource..foo": invalid step ""`,
+		},
+		{
+			name:  "unclosed index",
+			query: "foo[0.bar",
+			want:  `invalid query "foo[0.bar": unclosed index in "foo[0"`,
+		},
+		{
+			name:  "invalid index",
+			query: "foo[-1].bar",
+			want:  `invalid query "foo[-1].bar": index must be "*" or a non-negative integer, but got "-1"`,
+		},
+		{
+			name:  "index without name",
+			query: "[*].bar",
+			want:  `invalid query "[*].bar": invalid step "[*]"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseQuery(test.query)
+			if err == nil {
+				t.Fatal("expected an error, but got nil")
+			}
+			if err.Error() != test.want {
+				t.Errorf("want %q, but got %q", test.want, err.Error())
+			}
+		})
+	}
+}
+
+func TestQuerySchema(t *testing.T) {
+	// default error check helper
+	neverHappend := func(err error) bool { return err != nil }
+
+	tests := []struct {
+		name     string
+		paths    []string
+		want     *BodySchema
+		errCheck func(error) bool
+	}{
+		{
+			name:  "nested attribute",
+			paths: []string{"resource.aws_instance.*.ebs_block_device[*].volume_size"},
+			want: &BodySchema{
+				Blocks: []BlockSchema{
+					{
+						Type:       "resource",
+						LabelNames: []string{"label0", "label1"},
+						Body: &BodySchema{
+							Blocks: []BlockSchema{
+								{
+									Type:       "ebs_block_device",
+									LabelNames: []string{},
+									Body: &BodySchema{
+										Attributes: []AttributeSchema{{Name: "volume_size"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			errCheck: neverHappend,
+		},
+		{
+			name: "merge paths",
+			paths: []string{
+				"resource.aws_instance.*.instance_type",
+				"resource.aws_instance.*.ami",
+				"resource.aws_instance.*.instance_type",
+				"provider.aws.region",
+				"terraform.required_version",
+			},
+			want: &BodySchema{
+				Blocks: []BlockSchema{
+					{
+						Type:       "resource",
+						LabelNames: []string{"label0", "label1"},
+						Body: &BodySchema{
+							Attributes: []AttributeSchema{{Name: "instance_type"}, {Name: "ami"}},
+						},
+					},
+					{
+						Type:       "provider",
+						LabelNames: []string{"label0"},
+						Body: &BodySchema{
+							Attributes: []AttributeSchema{{Name: "region"}},
+						},
+					},
+					{
+						Type:       "terraform",
+						LabelNames: []string{},
+						Body: &BodySchema{
+							Attributes: []AttributeSchema{{Name: "required_version"}},
+						},
+					},
+				},
+			},
+			errCheck: neverHappend,
+		},
+		{
+			name:  "blocks",
+			paths: []string{"resource.aws_instance", "data.aws_ami.*.owners"},
+			want: &BodySchema{
+				Blocks: []BlockSchema{
+					{
+						Type:       "resource",
+						LabelNames: []string{"label0", "label1"},
+						Body:       &BodySchema{},
+					},
+					{
+						Type:       "data",
+						LabelNames: []string{"label0", "label1"},
+						Body: &BodySchema{
+							Attributes: []AttributeSchema{{Name: "owners"}},
+						},
+					},
+				},
+			},
+			errCheck: neverHappend,
+		},
+		{
+			name:  "all attributes",
+			paths: []string{"locals.foo", "locals.*"},
+			want: &BodySchema{
+				Blocks: []BlockSchema{
+					{
+						Type:       "locals",
+						LabelNames: []string{},
+						Body:       &BodySchema{Mode: SchemaJustAttributesMode},
+					},
+				},
+			},
+			errCheck: neverHappend,
+		},
+		{
+			name:  "blocks in a body with all attributes",
+			paths: []string{"resource.aws_instance.*.*", "resource.aws_instance.*.ebs_block_device[*]"},
+			errCheck: func(err error) bool {
+				return err == nil || err.Error() != `invalid query "resource.aws_instance.*.ebs_block_device[*]": cannot retrieve blocks in a body with all attributes`
+			},
+		},
+		{
+			name:  "all attributes in a body with blocks",
+			paths: []string{"resource.aws_instance.*.ebs_block_device[*]", "resource.aws_instance.*.*"},
+			errCheck: func(err error) bool {
+				return err == nil || err.Error() != `invalid query "resource.aws_instance.*.*": cannot retrieve all attributes in a body with blocks`
+			},
+		},
+		{
+			name:  "wildcard block type",
+			paths: []string{"resource.aws_wafv2_web_acl.*.rule[*].*[*].*[*].name"},
+			want: &BodySchema{
+				Blocks: []BlockSchema{
+					{
+						Type:       "resource",
+						LabelNames: []string{"label0", "label1"},
+						Body: &BodySchema{
+							Blocks: []BlockSchema{
+								{
+									Type:       "rule",
+									LabelNames: []string{},
+									Body:       &BodySchema{Mode: SchemaRecursiveMode, Depth: 2},
+								},
+							},
+						},
+					},
+				},
+			},
+			errCheck: neverHappend,
+		},
+		{
+			name: "merge into recursive schema",
+			paths: []string{
+				"resource.aws_wafv2_web_acl.*.*[*]",
+				"resource.aws_wafv2_web_acl.*.rule[*].statement[*].and_statement[*].name",
+				"resource.aws_wafv2_web_acl.*.name",
+			},
+			want: &BodySchema{
+				Blocks: []BlockSchema{
+					{
+						Type:       "resource",
+						LabelNames: []string{"label0", "label1"},
+						Body:       &BodySchema{Mode: SchemaRecursiveMode, Depth: 3},
+					},
+				},
+			},
+			errCheck: neverHappend,
+		},
+		{
+			name: "wildcard block type with declared blocks",
+			paths: []string{
+				"resource.aws_instance.*.ebs_block_device[*].volume_size",
+				"resource.aws_instance.*.*.foo",
+			},
+			want: &BodySchema{
+				Blocks: []BlockSchema{
+					{
+						Type:       "resource",
+						LabelNames: []string{"label0", "label1"},
+						Body:       &BodySchema{Mode: SchemaRecursiveMode, Depth: 1},
+					},
+				},
+			},
+			errCheck: neverHappend,
+		},
+		{
+			name: "labeled nested blocks",
+			paths: []string{
+				"resource.null_resource.*.provisioner.local-exec.command",
+				"resource.null_resource.*.dynamic.setting",
+				"check.health.data.http.*.url",
+				"terraform.backend[*]",
+			},
+			want: &BodySchema{
+				Blocks: []BlockSchema{
+					{
+						Type:       "resource",
+						LabelNames: []string{"label0", "label1"},
+						Body: &BodySchema{
+							Blocks: []BlockSchema{
+								{
+									Type:       "provisioner",
+									LabelNames: []string{"label0"},
+									Body: &BodySchema{
+										Attributes: []AttributeSchema{{Name: "command"}},
+									},
+								},
+								{
+									Type:       "dynamic",
+									LabelNames: []string{"label0"},
+									Body:       &BodySchema{},
+								},
+							},
+						},
+					},
+					{
+						Type:       "check",
+						LabelNames: []string{"label0"},
+						Body: &BodySchema{
+							Blocks: []BlockSchema{
+								{
+									Type:       "data",
+									LabelNames: []string{"label0", "label1"},
+									Body: &BodySchema{
+										Attributes: []AttributeSchema{{Name: "url"}},
+									},
+								},
+							},
+						},
+					},
+					{
+						Type:       "terraform",
+						LabelNames: []string{},
+						Body: &BodySchema{
+							Blocks: []BlockSchema{
+								{
+									Type:       "backend",
+									LabelNames: []string{"label0"},
+									Body:       &BodySchema{},
+								},
+							},
+						},
+					},
+				},
+			},
+			errCheck: neverHappend,
+		},
+		{
+			name:  "wildcard block type in a body with all attributes",
+			paths: []string{"locals.*", "locals.*[*]"},
+			errCheck: func(err error) bool {
+				return err == nil || err.Error() != `invalid query "locals.*[*]": cannot retrieve blocks in a body with all attributes`
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := QuerySchema(test.paths...)
+			if test.errCheck(err) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(test.want, got, cmp.Comparer(func(x, y cty.Type) bool { return x.Equals(y) })); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
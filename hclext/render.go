@@ -0,0 +1,315 @@
+package hclext
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Render returns formatted HCL source of the body content.
+//
+// Expressions are rendered from the original source if sources, a map of filenames to
+// file contents, has the file of the expression range. Otherwise, they are rendered
+// from the syntax tree, so comments and heredocs are not preserved.
+// Attributes are rendered in order of filename and position, followed by blocks.
+func (b *BodyContent) Render(sources map[string][]byte) []byte {
+	var buf bytes.Buffer
+	writeBody(&buf, b, sources)
+	return hclwrite.Format(buf.Bytes())
+}
+
+// Render returns formatted HCL source of the block.
+// See BodyContent.Render for details.
+func (b *Block) Render(sources map[string][]byte) []byte {
+	if b == nil {
+		return []byte{}
+	}
+	var buf bytes.Buffer
+	writeBlock(&buf, b, sources)
+	return hclwrite.Format(buf.Bytes())
+}
+
+// Render returns formatted HCL source of the attribute.
+// See BodyContent.Render for details.
+func (a *Attribute) Render(sources map[string][]byte) []byte {
+	if a == nil {
+		return []byte{}
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s = %s\n", a.Name, RenderExpr(a.Expr, sources))
+	return hclwrite.Format(buf.Bytes())
+}
+
+// RenderExpr returns HCL source of the expression.
+//
+// If sources has the file of the expression range, it returns the original source.
+// Otherwise, native syntax expressions are rendered from the syntax tree, and others
+// are rendered from their static value. Expressions that cannot be rendered in any way
+// are rendered as null with a comment of the range.
+func RenderExpr(expr hcl.Expression, sources map[string][]byte) []byte {
+	if expr == nil {
+		return []byte("null")
+	}
+
+	rng := expr.Range()
+	if src, exists := sources[rng.Filename]; exists && rng.Start.Byte <= rng.End.Byte && rng.End.Byte <= len(src) {
+		return rng.SliceBytes(src)
+	}
+
+	if syntaxExpr, ok := expr.(hclsyntax.Expression); ok {
+		if src, ok := renderSyntaxExpr(syntaxExpr); ok {
+			return []byte(src)
+		}
+	}
+
+	if traversal, diags := hcl.AbsTraversalForExpr(expr); !diags.HasErrors() {
+		return hclwrite.TokensForTraversal(traversal).Bytes()
+	}
+	// In JSON syntax, templates are evaluated as literal strings without EvalContext, so do not rely on it.
+	if val, diags := expr.Value(nil); !diags.HasErrors() && val.IsWhollyKnown() && len(expr.Variables()) == 0 {
+		return hclwrite.TokensForValue(val).Bytes()
+	}
+	return []byte(fmt.Sprintf("null /* expression at %s */", rng))
+}
+
+func writeBody(buf *bytes.Buffer, body *BodyContent, sources map[string][]byte) {
+	if body == nil {
+		return
+	}
+
+	for _, attr := range body.Attributes.Sorted() {
+		fmt.Fprintf(buf, "%s = %s\n", attr.Name, RenderExpr(attr.Expr, sources))
+	}
+	for i, block := range body.Blocks {
+		if i > 0 || len(body.Attributes) > 0 {
+			buf.WriteString("\n")
+		}
+		writeBlock(buf, block, sources)
+	}
+}
+
+func writeBlock(buf *bytes.Buffer, block *Block, sources map[string][]byte) {
+	buf.WriteString(block.Type)
+	for _, label := range block.Labels {
+		buf.WriteString(" ")
+		buf.Write(hclwrite.TokensForValue(cty.StringVal(label)).Bytes())
+	}
+
+	if block.Body.IsEmpty() {
+		buf.WriteString(" {}\n")
+		return
+	}
+	buf.WriteString(" {\n")
+	writeBody(buf, block.Body, sources)
+	buf.WriteString("}\n")
+}
+
+var operationSymbols = map[*hclsyntax.Operation]string{
+	hclsyntax.OpLogicalOr:          "||",
+	hclsyntax.OpLogicalAnd:         "&&",
+	hclsyntax.OpLogicalNot:         "!",
+	hclsyntax.OpEqual:              "==",
+	hclsyntax.OpNotEqual:           "!=",
+	hclsyntax.OpGreaterThan:        ">",
+	hclsyntax.OpGreaterThanOrEqual: ">=",
+	hclsyntax.OpLessThan:           "<",
+	hclsyntax.OpLessThanOrEqual:    "<=",
+	hclsyntax.OpAdd:                "+",
+	hclsyntax.OpSubtract:           "-",
+	hclsyntax.OpMultiply:           "*",
+	hclsyntax.OpDivide:             "/",
+	hclsyntax.OpModulo:             "%",
+	hclsyntax.OpNegate:             "-",
+}
+
+// renderSyntaxExpr renders the native syntax expression from the syntax tree.
+// It returns false if the expression contains nodes that cannot be rendered.
+func renderSyntaxExpr(expr hclsyntax.Expression) (string, bool) {
+	r := &syntaxRenderer{ok: true}
+	src := r.render(expr)
+	return src, r.ok
+}
+
+type syntaxRenderer struct {
+	ok bool
+}
+
+func (r *syntaxRenderer) render(expr hclsyntax.Expression) string {
+	switch e := expr.(type) {
+	case *hclsyntax.LiteralValueExpr:
+		return string(hclwrite.TokensForValue(e.Val).Bytes())
+	case *hclsyntax.ScopeTraversalExpr:
+		return string(hclwrite.TokensForTraversal(e.Traversal).Bytes())
+	case *hclsyntax.RelativeTraversalExpr:
+		return r.render(e.Source) + r.renderTraversal(e.Traversal)
+	case *hclsyntax.AnonSymbolExpr:
+		// Splat items are rendered as a part of the splat expression.
+		return ""
+	case *hclsyntax.ParenthesesExpr:
+		return "(" + r.render(e.Expression) + ")"
+	case *hclsyntax.FunctionCallExpr:
+		args := make([]string, len(e.Args))
+		for i, arg := range e.Args {
+			args[i] = r.render(arg)
+		}
+		if e.ExpandFinal && len(args) > 0 {
+			args[len(args)-1] += "..."
+		}
+		return e.Name + "(" + strings.Join(args, ", ") + ")"
+	case *hclsyntax.TupleConsExpr:
+		exprs := make([]string, len(e.Exprs))
+		for i, expr := range e.Exprs {
+			exprs[i] = r.render(expr)
+		}
+		return "[" + strings.Join(exprs, ", ") + "]"
+	case *hclsyntax.ObjectConsExpr:
+		if len(e.Items) == 0 {
+			return "{}"
+		}
+		var b strings.Builder
+		b.WriteString("{\n")
+		for _, item := range e.Items {
+			fmt.Fprintf(&b, "%s = %s\n", r.render(item.KeyExpr), r.render(item.ValueExpr))
+		}
+		b.WriteString("}")
+		return b.String()
+	case *hclsyntax.ObjectConsKeyExpr:
+		if _, paren := e.Wrapped.(*hclsyntax.ParenthesesExpr); e.ForceNonLiteral && !paren {
+			return "(" + r.render(e.Wrapped) + ")"
+		}
+		return r.render(e.Wrapped)
+	case *hclsyntax.TemplateExpr:
+		var b strings.Builder
+		b.WriteString(`"`)
+		for _, part := range e.Parts {
+			if lit, ok := part.(*hclsyntax.LiteralValueExpr); ok && lit.Val.Type() == cty.String && lit.Val.IsKnown() && !lit.Val.IsNull() {
+				b.WriteString(escapeTemplateLiteral(lit.Val.AsString()))
+				continue
+			}
+			b.WriteString("${" + r.render(part) + "}")
+		}
+		b.WriteString(`"`)
+		return b.String()
+	case *hclsyntax.TemplateWrapExpr:
+		return `"${` + r.render(e.Wrapped) + `}"`
+	case *hclsyntax.BinaryOpExpr:
+		return r.render(e.LHS) + " " + r.renderOperation(e.Op) + " " + r.render(e.RHS)
+	case *hclsyntax.UnaryOpExpr:
+		return r.renderOperation(e.Op) + r.render(e.Val)
+	case *hclsyntax.ConditionalExpr:
+		return r.render(e.Condition) + " ? " + r.render(e.TrueResult) + " : " + r.render(e.FalseResult)
+	case *hclsyntax.IndexExpr:
+		return r.render(e.Collection) + "[" + r.render(e.Key) + "]"
+	case *hclsyntax.SplatExpr:
+		return r.render(e.Source) + "[*]" + r.render(e.Each)
+	case *hclsyntax.ForExpr:
+		vars := e.ValVar
+		if e.KeyVar != "" {
+			vars = e.KeyVar + ", " + e.ValVar
+		}
+		body := r.render(e.ValExpr)
+		if e.KeyExpr != nil {
+			body = r.render(e.KeyExpr) + " => " + body
+			if e.Group {
+				body += "..."
+			}
+		}
+		if e.CondExpr != nil {
+			body += " if " + r.render(e.CondExpr)
+		}
+		src := fmt.Sprintf("for %s in %s : %s", vars, r.render(e.CollExpr), body)
+		if e.KeyExpr != nil {
+			return "{" + src + "}"
+		}
+		return "[" + src + "]"
+	default:
+		r.ok = false
+		return ""
+	}
+}
+
+func (r *syntaxRenderer) renderTraversal(traversal hcl.Traversal) string {
+	var b strings.Builder
+	for _, step := range traversal {
+		switch s := step.(type) {
+		case hcl.TraverseAttr:
+			b.WriteString("." + s.Name)
+		case hcl.TraverseIndex:
+			b.WriteString("[" + string(hclwrite.TokensForValue(s.Key).Bytes()) + "]")
+		case hcl.TraverseSplat:
+			b.WriteString("[*]")
+		default:
+			r.ok = false
+		}
+	}
+	return b.String()
+}
+
+func (r *syntaxRenderer) renderOperation(op *hclsyntax.Operation) string {
+	symbol, exists := operationSymbols[op]
+	if !exists {
+		r.ok = false
+	}
+	return symbol
+}
+
+// escapeTemplateLiteral escapes the literal part of a quoted template.
+func escapeTemplateLiteral(s string) string {
+	src := string(hclwrite.TokensForValue(cty.StringVal(s)).Bytes())
+	return src[1 : len(src)-1]
+}
+
+// String returns HCL source of the body content. This is useful for debugging.
+// To render expressions from the original source, use Render instead.
+func (b *BodyContent) String() string {
+	return string(b.Render(nil))
+}
+
+// GoString returns a representation of the body content with ranges for debugging.
+func (b *BodyContent) GoString() string {
+	if b == nil {
+		return "(*hclext.BodyContent)(nil)"
+	}
+
+	parts := []string{}
+	for _, attr := range b.Attributes.Sorted() {
+		parts = append(parts, attr.GoString())
+	}
+	for _, block := range b.Blocks {
+		parts = append(parts, block.GoString())
+	}
+	return "&hclext.BodyContent{" + strings.Join(parts, ", ") + "}"
+}
+
+// String returns HCL source of the block. This is useful for debugging.
+// To render expressions from the original source, use Render instead.
+func (b *Block) String() string {
+	return string(b.Render(nil))
+}
+
+// GoString returns a representation of the block with ranges for debugging.
+func (b *Block) GoString() string {
+	if b == nil {
+		return "(*hclext.Block)(nil)"
+	}
+	return fmt.Sprintf("&hclext.Block{Type: %q, Labels: %#v, DefRange: %q, Body: %#v}", b.Type, b.Labels, b.DefRange.String(), b.Body)
+}
+
+// String returns HCL source of the attribute. This is useful for debugging.
+// To render expressions from the original source, use Render instead.
+func (a *Attribute) String() string {
+	return string(a.Render(nil))
+}
+
+// GoString returns a representation of the attribute with ranges for debugging.
+func (a *Attribute) GoString() string {
+	if a == nil {
+		return "(*hclext.Attribute)(nil)"
+	}
+	return fmt.Sprintf("&hclext.Attribute{Name: %q, Expr: %q, Range: %q}", a.Name, RenderExpr(a.Expr, nil), a.Range.String())
+}
@@ -0,0 +1,242 @@
+package hclext
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/json"
+)
+
+func TestRender(t *testing.T) {
+	src := `
+resource "aws_instance" "main" {
+  ami           = "ami-123456" # comment
+  instance_type = var.instance_type
+
+  ebs_block_device {
+    volume_size = 10
+  }
+  ebs_block_device {}
+}
+`
+	schema := &BodySchema{
+		Blocks: []BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &BodySchema{
+					Attributes: []AttributeSchema{{Name: "ami"}, {Name: "instance_type"}},
+					Blocks: []BlockSchema{
+						{
+							Type: "ebs_block_device",
+							Body: &BodySchema{Attributes: []AttributeSchema{{Name: "volume_size"}}},
+						},
+					},
+				},
+			},
+		},
+	}
+	file, diags := hclsyntax.ParseConfig([]byte(src), "main.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	content, diags := Content(file.Body, schema)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+
+	want := `resource "aws_instance" "main" {
+  ami           = "ami-123456"
+  instance_type = var.instance_type
+
+  ebs_block_device {
+    volume_size = 10
+  }
+
+  ebs_block_device {}
+}
+`
+	if diff := cmp.Diff(want, string(content.Render(map[string][]byte{"main.tf": []byte(src)}))); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(want, content.String()); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(want, content.Blocks[0].String()); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff("ami = \"ami-123456\"\n", content.Blocks[0].Body.Attributes["ami"].String()); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestRenderExpr(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "literal",
+			src:  `1`,
+			want: `1`,
+		},
+		{
+			name: "string",
+			src:  `"foo \"bar\" $${baz}"`,
+			want: `"foo \"bar\" $${baz}"`,
+		},
+		{
+			name: "template",
+			src:  `"${var.foo}-bar"`,
+			want: `"${var.foo}-bar"`,
+		},
+		{
+			name: "template wrap",
+			src:  `"${var.foo}"`,
+			want: `"${var.foo}"`,
+		},
+		{
+			name: "heredoc",
+			src:  "<<EOF\nfoo ${var.bar}\nEOF\n",
+			want: `"foo ${var.bar}\n"`,
+		},
+		{
+			name: "traversal",
+			src:  `aws_instance.main[0].tags["Name"]`,
+			want: `aws_instance.main[0].tags["Name"]`,
+		},
+		{
+			name: "relative traversal",
+			src:  `values(var.foo)[0].bar`,
+			want: `values(var.foo)[0].bar`,
+		},
+		{
+			name: "function call",
+			src:  `concat(var.foo, var.bar...)`,
+			want: `concat(var.foo, var.bar...)`,
+		},
+		{
+			name: "provider function call",
+			src:  `provider::aws::arn_parse(var.arn)`,
+			want: `provider::aws::arn_parse(var.arn)`,
+		},
+		{
+			name: "tuple",
+			src:  `[1, var.foo]`,
+			want: `[1, var.foo]`,
+		},
+		{
+			name: "object",
+			src:  `{ foo = 1, (var.bar) = 2, "baz" = 3 }`,
+			want: "{\nfoo = 1\n(var.bar) = 2\n\"baz\" = 3\n}",
+		},
+		{
+			name: "operations",
+			src:  `!var.foo && (var.bar + 1) * -2 >= 3`,
+			want: `!var.foo && (var.bar + 1) * -2 >= 3`,
+		},
+		{
+			name: "conditional",
+			src:  `var.foo ? "a" : "b"`,
+			want: `var.foo ? "a" : "b"`,
+		},
+		{
+			name: "index",
+			src:  `var.foo[var.bar]`,
+			want: `var.foo[var.bar]`,
+		},
+		{
+			name: "splat",
+			src:  `aws_instance.main[*].tags.Name`,
+			want: `aws_instance.main[*].tags.Name`,
+		},
+		{
+			name: "for tuple",
+			src:  `[for i, v in var.list : v if i > 0]`,
+			want: `[for i, v in var.list : v if i > 0]`,
+		},
+		{
+			name: "for object",
+			src:  `{for k, v in var.map : v => k...}`,
+			want: `{for k, v in var.map : v => k...}`,
+		},
+		{
+			name: "template if directive",
+			src:  `"%{ if var.foo }bar%{ endif }"`,
+			want: `"${var.foo ? "bar" : ""}"`,
+		},
+		{
+			name: "template for directive",
+			src:  `"%{ for v in var.list }${v}%{ endfor }"`,
+			want: `null /* expression at test.tf:1,1-40 */`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expr, diags := hclsyntax.ParseExpression([]byte(test.src), "test.tf", hcl.InitialPos)
+			if diags.HasErrors() {
+				t.Fatal(diags)
+			}
+
+			got := string(RenderExpr(expr, nil))
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Error(diff)
+			}
+
+			// Rendered expressions must be parsed again, except for unsupported ones.
+			if _, diags := hclsyntax.ParseExpression([]byte(got), "rendered.tf", hcl.InitialPos); diags.HasErrors() {
+				t.Errorf("failed to parse the rendered expression: %s", diags)
+			}
+		})
+	}
+}
+
+func TestRenderExpr_json(t *testing.T) {
+	expr, diags := json.ParseExpression([]byte(`{"foo": "${var.foo}", "bar": [1, 2]}`), "test.tf.json")
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+
+	if diff := cmp.Diff(`{"foo": "${var.foo}", "bar": [1, 2]}`, string(RenderExpr(expr, map[string][]byte{"test.tf.json": []byte(`{"foo": "${var.foo}", "bar": [1, 2]}`)}))); diff != "" {
+		t.Error(diff)
+	}
+	// JSON expressions with references cannot be rendered without sources.
+	if diff := cmp.Diff(`null /* expression at test.tf.json:1,1-37 */`, string(RenderExpr(expr, nil))); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestGoString(t *testing.T) {
+	file, diags := hclsyntax.ParseConfig([]byte(`
+foo = var.foo
+block "label" {}
+`), "main.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	content, diags := Content(file.Body, &BodySchema{
+		Attributes: []AttributeSchema{{Name: "foo"}},
+		Blocks:     []BlockSchema{{Type: "block", LabelNames: []string{"name"}}},
+	})
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+
+	want := `&hclext.BodyContent{&hclext.Attribute{Name: "foo", Expr: "var.foo", Range: "main.tf:2,1-14"}, &hclext.Block{Type: "block", Labels: []string{"label"}, DefRange: "main.tf:3,1-14", Body: &hclext.BodyContent{}}}`
+	if diff := cmp.Diff(want, fmt.Sprintf("%#v", content)); diff != "" {
+		t.Error(diff)
+	}
+
+	var block *Block
+	if diff := cmp.Diff("(*hclext.Block)(nil)", fmt.Sprintf("%#v", block)); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff("", block.String()); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+
+	"github.com/zclconf/go-cty/cty"
 )
 
 // SchemaMode controls how the body's schema is declared.
@@ -18,6 +20,12 @@ const (
 	// SchemaJustAttributesMode is the mode to extract body as attributes.
 	// In this mode you don't need to declare schema for attributes or blocks.
 	SchemaJustAttributesMode
+	// SchemaRecursiveMode is the mode to extract all attributes and nested blocks recursively.
+	// Declared attributes and blocks are extracted according to their schema, and others are
+	// extracted up to the depth of the body schema. This mode is only available for bodies
+	// in native syntax. For other bodies, such as JSON, only declared ones are extracted.
+	// Older TFLint versions do not support this mode, and plugins return an error instead of sending it.
+	SchemaRecursiveMode
 )
 
 // BodySchema represents the desired body.
@@ -26,19 +34,39 @@ type BodySchema struct {
 	Mode       SchemaMode
 	Attributes []AttributeSchema
 	Blocks     []BlockSchema
+
+	// Depth is the maximum nesting level of undeclared blocks in SchemaRecursiveMode.
+	// For example, 1 extracts blocks in the body with their attributes, but not blocks nested in them.
+	// 0 means no limit.
+	Depth int
 }
 
 // AttributeSchema represents the desired attribute.
 // This structure is designed to have attributes similar to hcl.AttributeSchema.
+//
+// Unlike hcl.AttributeSchema, you can declare the expected type of the attribute value.
+// If Type is set, Content and PartialContent return diagnostics for values that cannot be
+// converted to the type. Values that cannot be evaluated statically, such as references
+// to variables, are not validated. If LiteralOnly is true, Content and PartialContent
+// also return diagnostics for such values.
+//
+// Older TFLint versions ignore these constraints. In that case, plugins validate the content
+// returned from GetModuleContent themselves with ValidateContent.
 type AttributeSchema struct {
 	Name     string
 	Required bool
+
+	// Type is the expected type of the attribute value. cty.NilType means any type.
+	Type cty.Type
+	// LiteralOnly requires the attribute value to be a literal, i.e. an expression
+	// that can be evaluated without variables and functions.
+	LiteralOnly bool
 }
 
 // BlockSchema represents the desired block header and body schema.
 // Unlike hcl.BlockHeaderSchema, this can set nested body schema.
 // Instead, hclext.Block can't handle abstract values like hcl.Body,
-// so you need to specify all nested schemas at once.
+// so you need to specify all nested schemas at once, or use SchemaRecursiveMode.
 type BlockSchema struct {
 	Type       string
 	LabelNames []string
@@ -50,8 +78,13 @@ type BlockSchema struct {
 // Unlike gohcl.ImpliedBodySchema, it produces nested schemas.
 // This method differs from gohcl.DecodeBody in several ways:
 //
-// - Does not support `body` and `remain` tags.
-// - Does not support partial schema.
+// - Does not return whether the schema is partial.
+// - If there is a `remain` field of hclext.Attributes and no `block` fields, the schema is SchemaJustAttributesMode.
+// - If there is any other `remain` field, the schema is SchemaRecursiveMode.
+//
+// In these modes, all attributes and blocks are retrieved to collect the rest of them into the `remain` field.
+// Note that undeclared attributes and blocks are not retrieved from JSON bodies in SchemaRecursiveMode.
+// `body` fields do not affect the schema.
 //
 // @see https://github.com/hashicorp/hcl/blob/v2.11.1/gohcl/schema.go
 func ImpliedBodySchema(val interface{}) *BodySchema {
@@ -133,6 +166,20 @@ func impliedBodySchema(ty reflect.Type) *BodySchema {
 		})
 	}
 
+	if tags.Remain != nil {
+		// Retrieve all attributes to collect the rest of attributes into the remain field.
+		if ty.Field(*tags.Remain).Type == attributesType && len(blockSchemas) == 0 {
+			return &BodySchema{Mode: SchemaJustAttributesMode}
+		}
+		// Undeclared blocks can only be retrieved in SchemaRecursiveMode.
+		// Declared attributes and blocks are retrieved with the schema as usual.
+		return &BodySchema{
+			Mode:       SchemaRecursiveMode,
+			Attributes: attrSchemas,
+			Blocks:     blockSchemas,
+		}
+	}
+
 	return &BodySchema{
 		Attributes: attrSchemas,
 		Blocks:     blockSchemas,
@@ -144,8 +191,16 @@ type fieldTags struct {
 	Blocks     map[string]int
 	Labels     []labelField
 	Optional   map[string]bool
+	Remain     *int
+	Body       *int
 }
 
+var (
+	attributesType     = reflect.TypeOf(Attributes{})
+	bodyContentType    = reflect.TypeOf(BodyContent{})
+	bodyContentPtrType = reflect.TypeOf(&BodyContent{})
+)
+
 type labelField struct {
 	FieldIndex int
 	Name       string
@@ -189,8 +244,24 @@ func getFieldTags(ty reflect.Type) *fieldTags {
 		case "optional":
 			ret.Attributes[name] = i
 			ret.Optional[name] = true
-		case "remain", "body":
-			panic(fmt.Sprintf("'%s' tag is permitted in HCL, but not permitted in schema", kind))
+		case "remain":
+			if ret.Remain != nil {
+				panic("only one 'remain' tag is permitted")
+			}
+			if field.Type != attributesType && field.Type != bodyContentType && field.Type != bodyContentPtrType {
+				panic(fmt.Sprintf("'remain' tag cannot be applied to %s field %s: hclext.Attributes, hclext.BodyContent, or *hclext.BodyContent required", field.Type.String(), field.Name))
+			}
+			idx := i // copy, because this loop will continue assigning to i
+			ret.Remain = &idx
+		case "body":
+			if ret.Body != nil {
+				panic("only one 'body' tag is permitted")
+			}
+			if field.Type != bodyContentType && field.Type != bodyContentPtrType {
+				panic(fmt.Sprintf("'body' tag cannot be applied to %s field %s: hclext.BodyContent or *hclext.BodyContent required", field.Type.String(), field.Name))
+			}
+			idx := i // copy, because this loop will continue assigning to i
+			ret.Body = &idx
 		default:
 			panic(fmt.Sprintf("invalid schema field tag kind %q on %s %q", kind, field.Type.String(), field.Name))
 		}
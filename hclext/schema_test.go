@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/zclconf/go-cty/cty"
 )
 
 func TestImpliedBodySchema(t *testing.T) {
@@ -24,6 +25,57 @@ func TestImpliedBodySchema(t *testing.T) {
 			}{},
 			Want: &BodySchema{},
 		},
+		{
+			Name: "remain attributes",
+			Val: struct {
+				Attr   bool       `hclext:"attr"`
+				Remain Attributes `hclext:",remain"`
+			}{},
+			Want: &BodySchema{Mode: SchemaJustAttributesMode},
+		},
+		{
+			Name: "remain body in blocks",
+			Val: struct {
+				Attr   bool `hclext:"attr"`
+				Nested struct {
+					Remain *BodyContent `hclext:",remain"`
+				} `hclext:"nested,block"`
+				Body BodyContent `hclext:",body"`
+			}{},
+			Want: &BodySchema{
+				Attributes: []AttributeSchema{{Name: "attr", Required: true}},
+				Blocks: []BlockSchema{
+					{
+						Type: "nested",
+						Body: &BodySchema{Mode: SchemaRecursiveMode},
+					},
+				},
+			},
+		},
+		{
+			Name: "remain body with blocks",
+			Val: struct {
+				Attr   bool         `hclext:"attr"`
+				Nested struct{}     `hclext:"nested,block"`
+				Remain *BodyContent `hclext:",remain"`
+			}{},
+			Want: &BodySchema{
+				Mode:       SchemaRecursiveMode,
+				Attributes: []AttributeSchema{{Name: "attr", Required: true}},
+				Blocks:     []BlockSchema{{Type: "nested", Body: &BodySchema{}}},
+			},
+		},
+		{
+			Name: "remain attributes with blocks",
+			Val: struct {
+				Nested struct{}   `hclext:"nested,block"`
+				Remain Attributes `hclext:",remain"`
+			}{},
+			Want: &BodySchema{
+				Mode:   SchemaRecursiveMode,
+				Blocks: []BlockSchema{{Type: "nested", Body: &BodySchema{}}},
+			},
+		},
 		{
 			Name: "attribute tags",
 			Val: struct {
@@ -193,7 +245,7 @@ func TestImpliedBodySchema(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
 			got := ImpliedBodySchema(test.Val)
-			if diff := cmp.Diff(test.Want, got); diff != "" {
+			if diff := cmp.Diff(test.Want, got, cmp.Comparer(func(x, y cty.Type) bool { return x.Equals(y) })); diff != "" {
 				t.Errorf("wrong schema\ndiff:  %s", diff)
 			}
 		})
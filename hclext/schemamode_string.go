@@ -10,11 +10,12 @@ func _() {
 	var x [1]struct{}
 	_ = x[SchemaDefaultMode-0]
 	_ = x[SchemaJustAttributesMode-1]
+	_ = x[SchemaRecursiveMode-2]
 }
 
-const _SchemaMode_name = "SchemaDefaultModeSchemaJustAttributesMode"
+const _SchemaMode_name = "SchemaDefaultModeSchemaJustAttributesModeSchemaRecursiveMode"
 
-var _SchemaMode_index = [...]uint8{0, 17, 41}
+var _SchemaMode_index = [...]uint8{0, 17, 41, 60}
 
 func (i SchemaMode) String() string {
 	if i < 0 || i >= SchemaMode(len(_SchemaMode_index)-1) {
@@ -3,8 +3,13 @@ package hclext
 import (
 	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/terraform/addrs"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
 )
 
 // BodyContent is the result of applying a hclext.BodySchema to a hcl.Body.
@@ -31,6 +36,29 @@ type Block struct {
 	DefRange    hcl.Range
 	TypeRange   hcl.Range
 	LabelRanges []hcl.Range
+
+	// Instance is set if the block is expanded by GetModuleContent with ExpandModeExpand.
+	// It is nil for blocks that are not expanded. Since only the host expands blocks,
+	// it is also nil for blocks returned by helper.Runner.
+	Instance *BlockInstance
+}
+
+// BlockInstance is metadata of a block expanded from a block with `count` or `for_each`,
+// or generated from a `dynamic` block.
+type BlockInstance struct {
+	// Key is the instance key. This is addrs.IntKey for `count`, and addrs.StringKey or
+	// addrs.IntKey for `for_each`. For dynamic blocks, this is the key of the iterator.
+	Key addrs.InstanceKey
+	// Value is the value of `each.value`, or the value of the iterator for dynamic blocks.
+	// This is cty.NilVal if not available, such as blocks expanded by `count`.
+	Value cty.Value
+	// Dynamic is true if the block is generated from a `dynamic` block.
+	Dynamic bool
+}
+
+// HasValue returns whether the value of `each.value` or the iterator is available.
+func (i *BlockInstance) HasValue() bool {
+	return i != nil && i.Value != cty.NilVal
 }
 
 // Attributes is a set of attributes keyed by their names.
@@ -52,6 +80,18 @@ type Attribute struct {
 // Convert hclext.BodySchema to hcl.BodySchema, and convert hcl.BodyContent
 // to hclext.BodyContent. It processes the nested body recursively.
 func Content(body hcl.Body, schema *BodySchema) (*BodyContent, hcl.Diagnostics) {
+	return bodyContent(body, schema, false)
+}
+
+// PartialContent is a wrapper for hcl.PartialContent for working with nested schemas.
+// Convert hclext.BodySchema to hcl.BodySchema, and convert hcl.BodyContent
+// to hclext.BodyContent. It processes the nested body recursively.
+// Unlike hcl.PartialContent, it does not return the rest of the body.
+func PartialContent(body hcl.Body, schema *BodySchema) (*BodyContent, hcl.Diagnostics) {
+	return bodyContent(body, schema, true)
+}
+
+func bodyContent(body hcl.Body, schema *BodySchema, partial bool) (*BodyContent, hcl.Diagnostics) {
 	if reflect.ValueOf(body).IsNil() {
 		return &BodyContent{}, hcl.Diagnostics{}
 	}
@@ -63,22 +103,42 @@ func Content(body hcl.Body, schema *BodySchema) (*BodyContent, hcl.Diagnostics)
 		Attributes: make([]hcl.AttributeSchema, len(schema.Attributes)),
 		Blocks:     make([]hcl.BlockHeaderSchema, len(schema.Blocks)),
 	}
-	for idx, attrS := range schema.Attributes {
-		hclS.Attributes[idx] = hcl.AttributeSchema{Name: attrS.Name, Required: attrS.Required}
+	attrS := map[string]AttributeSchema{}
+	for idx, schema := range schema.Attributes {
+		hclS.Attributes[idx] = hcl.AttributeSchema{Name: schema.Name, Required: schema.Required}
+		attrS[schema.Name] = schema
 	}
 	childS := map[string]*BodySchema{}
 	for idx, blockS := range schema.Blocks {
 		hclS.Blocks[idx] = hcl.BlockHeaderSchema{Type: blockS.Type, LabelNames: blockS.LabelNames}
 		childS[blockS.Type] = blockS.Body
 	}
+	// Blocks of these types are retrieved partially because they are beyond the depth.
+	partialS := map[string]bool{}
 
 	content := &hcl.BodyContent{}
 	var diags hcl.Diagnostics
 	switch schema.Mode {
 	case SchemaDefaultMode:
-		content, diags = body.Content(hclS)
+		if partial {
+			content, _, diags = body.PartialContent(hclS)
+		} else {
+			content, diags = body.Content(hclS)
+		}
 	case SchemaJustAttributesMode:
 		content.Attributes, diags = body.JustAttributes()
+	case SchemaRecursiveMode:
+		var undeclared []*hcl.Block
+		if syntaxBody, ok := body.(*hclsyntax.Body); ok {
+			undeclared = expandRecursiveSchema(syntaxBody, schema, hclS, attrS, childS, partialS)
+		}
+		content, _, diags = body.PartialContent(hclS)
+		if len(undeclared) > 0 {
+			content.Blocks = append(content.Blocks, undeclared...)
+			sort.SliceStable(content.Blocks, func(i, j int) bool {
+				return content.Blocks[i].DefRange.Start.Byte < content.Blocks[j].DefRange.Start.Byte
+			})
+		}
 	default:
 		panic(fmt.Sprintf("invalid SchemaMode: %s", schema.Mode))
 	}
@@ -88,6 +148,7 @@ func Content(body hcl.Body, schema *BodySchema) (*BodyContent, hcl.Diagnostics)
 		Blocks:     make(Blocks, len(content.Blocks)),
 	}
 	for name, attr := range content.Attributes {
+		diags = diags.Extend(validateAttribute(attr, attrS[name]))
 		ret.Attributes[name] = &Attribute{
 			Name:      attr.Name,
 			Expr:      attr.Expr,
@@ -96,7 +157,7 @@ func Content(body hcl.Body, schema *BodySchema) (*BodyContent, hcl.Diagnostics)
 		}
 	}
 	for idx, block := range content.Blocks {
-		child, childDiags := Content(block.Body, childS[block.Type])
+		child, childDiags := bodyContent(block.Body, childS[block.Type], partial || partialS[block.Type])
 		diags = diags.Extend(childDiags)
 
 		ret.Blocks[idx] = &Block{
@@ -112,69 +173,149 @@ func Content(body hcl.Body, schema *BodySchema) (*BodyContent, hcl.Diagnostics)
 	return ret, diags
 }
 
-// PartialContent is a wrapper for hcl.PartialContent for working with nested schemas.
-// Convert hclext.BodySchema to hcl.BodySchema, and convert hcl.BodyContent
-// to hclext.BodyContent. It processes the nested body recursively.
-// Unlike hcl.PartialContent, it does not return the rest of the body.
-func PartialContent(body hcl.Body, schema *BodySchema) (*BodyContent, hcl.Diagnostics) {
-	if reflect.ValueOf(body).IsNil() {
-		return &BodyContent{}, hcl.Diagnostics{}
+// expandRecursiveSchema adds undeclared attributes and blocks in the body to the schema.
+// Nested blocks are retrieved with the remaining depth. If no depth remains,
+// only attributes of the blocks are retrieved.
+//
+// Undeclared blocks are returned instead of being added to the header schema,
+// because blocks of the same type can have a different number of labels.
+func expandRecursiveSchema(
+	body *hclsyntax.Body,
+	schema *BodySchema,
+	hclS *hcl.BodySchema,
+	attrS map[string]AttributeSchema,
+	childS map[string]*BodySchema,
+	partialS map[string]bool,
+) []*hcl.Block {
+	for _, name := range sortedAttributeNames(body.Attributes) {
+		if _, declared := attrS[name]; !declared {
+			hclS.Attributes = append(hclS.Attributes, hcl.AttributeSchema{Name: name})
+		}
 	}
-	if schema == nil {
-		schema = &BodySchema{}
+
+	// If no depth remains, collect attributes of all blocks of the type because they can be different from each other.
+	attrsOfType := map[string]map[string]bool{}
+	declared := map[string]bool{}
+	for _, blockS := range hclS.Blocks {
+		declared[blockS.Type] = true
 	}
+	var undeclared []*hcl.Block
+	for _, block := range body.Blocks {
+		if declared[block.Type] {
+			continue
+		}
+		undeclared = append(undeclared, block.AsHCLBlock())
+
+		if _, exists := childS[block.Type]; !exists {
+			switch schema.Depth {
+			case 0:
+				childS[block.Type] = &BodySchema{Mode: SchemaRecursiveMode}
+			case 1:
+				childS[block.Type] = &BodySchema{}
+				partialS[block.Type] = true
+				attrsOfType[block.Type] = map[string]bool{}
+			default:
+				childS[block.Type] = &BodySchema{Mode: SchemaRecursiveMode, Depth: schema.Depth - 1}
+			}
+		}
 
-	hclS := &hcl.BodySchema{
-		Attributes: make([]hcl.AttributeSchema, len(schema.Attributes)),
-		Blocks:     make([]hcl.BlockHeaderSchema, len(schema.Blocks)),
+		if attrs, exists := attrsOfType[block.Type]; exists {
+			for name := range block.Body.Attributes {
+				attrs[name] = true
+			}
+		}
 	}
-	for idx, attrS := range schema.Attributes {
-		hclS.Attributes[idx] = hcl.AttributeSchema{Name: attrS.Name, Required: attrS.Required}
+
+	for blockType, attrs := range attrsOfType {
+		names := make([]string, 0, len(attrs))
+		for name := range attrs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			childS[blockType].Attributes = append(childS[blockType].Attributes, AttributeSchema{Name: name})
+		}
 	}
-	childS := map[string]*BodySchema{}
-	for idx, blockS := range schema.Blocks {
-		hclS.Blocks[idx] = hcl.BlockHeaderSchema{Type: blockS.Type, LabelNames: blockS.LabelNames}
-		childS[blockS.Type] = blockS.Body
+
+	return undeclared
+}
+
+func sortedAttributeNames(attrs hclsyntax.Attributes) []string {
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
 	}
+	sort.Strings(names)
+	return names
+}
 
-	content := &hcl.BodyContent{}
-	var diags hcl.Diagnostics
-	switch schema.Mode {
-	case SchemaDefaultMode:
-		content, _, diags = body.PartialContent(hclS)
-	case SchemaJustAttributesMode:
-		content.Attributes, diags = body.JustAttributes()
-	default:
-		panic(fmt.Sprintf("invalid SchemaMode: %s", schema.Mode))
+// ValidateContent checks the attribute values in the content against the type and literal constraints of the schema.
+// Content and PartialContent already do this, so this is only needed for content retrieved
+// in other ways, such as from hosts that do not support these constraints.
+func ValidateContent(content *BodyContent, schema *BodySchema) hcl.Diagnostics {
+	diags := hcl.Diagnostics{}
+	if content == nil || schema == nil {
+		return diags
 	}
 
-	ret := &BodyContent{
-		Attributes: Attributes{},
-		Blocks:     make(Blocks, len(content.Blocks)),
+	for _, attrS := range schema.Attributes {
+		if attr, exists := content.Attributes[attrS.Name]; exists {
+			diags = diags.Extend(validateAttribute(&hcl.Attribute{Name: attr.Name, Expr: attr.Expr}, attrS))
+		}
 	}
-	for name, attr := range content.Attributes {
-		ret.Attributes[name] = &Attribute{
-			Name:      attr.Name,
-			Expr:      attr.Expr,
-			Range:     attr.Range,
-			NameRange: attr.NameRange,
+	for _, blockS := range schema.Blocks {
+		for _, block := range content.Blocks {
+			if block.Type == blockS.Type {
+				diags = diags.Extend(ValidateContent(block.Body, blockS.Body))
+			}
 		}
 	}
-	for idx, block := range content.Blocks {
-		child, childDiags := PartialContent(block.Body, childS[block.Type])
-		diags = diags.Extend(childDiags)
+	return diags
+}
 
-		ret.Blocks[idx] = &Block{
-			Type:        block.Type,
-			Labels:      block.Labels,
-			Body:        child,
-			DefRange:    block.DefRange,
-			TypeRange:   block.TypeRange,
-			LabelRanges: block.LabelRanges,
+// validateAttribute checks the attribute value against the type and literal constraints of the schema.
+func validateAttribute(attr *hcl.Attribute, schema AttributeSchema) hcl.Diagnostics {
+	if schema.Type == cty.NilType && !schema.LiteralOnly {
+		return nil
+	}
+
+	var val cty.Value
+	var valDiags hcl.Diagnostics
+	if len(attr.Expr.Variables()) > 0 {
+		valDiags = hcl.Diagnostics{{Severity: hcl.DiagError}}
+	} else {
+		val, valDiags = attr.Expr.Value(nil)
+	}
+	if valDiags.HasErrors() {
+		if !schema.LiteralOnly {
+			return nil
+		}
+		return hcl.Diagnostics{
+			{
+				Severity:   hcl.DiagError,
+				Summary:    "Invalid expression",
+				Detail:     fmt.Sprintf(`The value of "%s" must be a literal value. Variables and functions are not allowed here.`, attr.Name),
+				Subject:    attr.Expr.Range().Ptr(),
+				Expression: attr.Expr,
+			},
 		}
 	}
 
-	return ret, diags
+	if schema.Type == cty.NilType {
+		return nil
+	}
+	if _, err := convert.Convert(val, schema.Type); err != nil {
+		return hcl.Diagnostics{
+			{
+				Severity:   hcl.DiagError,
+				Summary:    "Incorrect attribute value type",
+				Detail:     fmt.Sprintf(`Inappropriate value for attribute "%s": %s.`, attr.Name, err.Error()),
+				Subject:    attr.Expr.Range().Ptr(),
+				Expression: attr.Expr,
+			},
+		}
+	}
+	return nil
 }
 
 // IsEmpty returns whether the body content is empty
@@ -201,9 +342,10 @@ func (b *BodyContent) Copy() *BodyContent {
 }
 
 // WalkAttributes visits all attributes with the passed walker function.
+// Attributes in the same body are visited in order of filename and position.
 func (b *BodyContent) WalkAttributes(walker func(*Attribute) hcl.Diagnostics) hcl.Diagnostics {
 	var diags hcl.Diagnostics
-	for _, attr := range b.Attributes {
+	for _, attr := range b.Attributes.Sorted() {
 		walkDiags := walker(attr)
 		diags = diags.Extend(walkDiags)
 	}
@@ -214,6 +356,43 @@ func (b *BodyContent) WalkAttributes(walker func(*Attribute) hcl.Diagnostics) hc
 	return diags
 }
 
+// SortBlocks sorts blocks in the body content by filename and position recursively.
+// Blocks in the same position, such as expanded dynamic blocks, keep their order.
+func (b *BodyContent) SortBlocks() {
+	if b == nil {
+		return
+	}
+	sort.SliceStable(b.Blocks, func(i, j int) bool {
+		return rangeLess(b.Blocks[i].DefRange, b.Blocks[j].DefRange)
+	})
+	for _, block := range b.Blocks {
+		block.Body.SortBlocks()
+	}
+}
+
+// Sorted returns attributes ordered by filename and position.
+func (as Attributes) Sorted() []*Attribute {
+	ret := make([]*Attribute, 0, len(as))
+	for _, attr := range as {
+		ret = append(ret, attr)
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].Range == ret[j].Range {
+			return ret[i].Name < ret[j].Name
+		}
+		return rangeLess(ret[i].Range, ret[j].Range)
+	})
+	return ret
+}
+
+// rangeLess reports whether the range a is before b in order of filename and position.
+func rangeLess(a, b hcl.Range) bool {
+	if a.Filename != b.Filename {
+		return a.Filename < b.Filename
+	}
+	return a.Start.Byte < b.Start.Byte
+}
+
 // AsNative returns self as hcl.Attributes
 func (as Attributes) AsNative() hcl.Attributes {
 	ret := hcl.Attributes{}
@@ -258,6 +437,17 @@ func (els Blocks) OfType(typeName string) Blocks {
 	return ret
 }
 
+// Sorted returns a new block sequence ordered by filename and position.
+// Blocks in the same position, such as expanded dynamic blocks, keep their order.
+func (els Blocks) Sorted() Blocks {
+	ret := make(Blocks, len(els))
+	copy(ret, els)
+	sort.SliceStable(ret, func(i, j int) bool {
+		return rangeLess(ret[i].DefRange, ret[j].DefRange)
+	})
+	return ret
+}
+
 // ByType transforms the receiving block sequence into a map from type
 // name to block sequences of only that type.
 func (els Blocks) ByType() map[string]Blocks {
@@ -285,6 +475,10 @@ func (b *Block) Copy() *Block {
 
 	copy(out.Labels, b.Labels)
 	copy(out.LabelRanges, b.LabelRanges)
+	if b.Instance != nil {
+		instance := *b.Instance
+		out.Instance = &instance
+	}
 
 	return out
 }
@@ -1,12 +1,16 @@
 package hclext
 
 import (
+	"fmt"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/json"
 	"github.com/zclconf/go-cty/cty"
 )
 
@@ -698,6 +702,335 @@ func TestContent_PartialContent(t *testing.T) {
 	}
 }
 
+func TestContent_TypedAttributes(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Src    string
+		Schema AttributeSchema
+		Want   []string
+	}{
+		{
+			Name:   "string",
+			Src:    `foo = "bar"`,
+			Schema: AttributeSchema{Name: "foo", Type: cty.String},
+			Want:   []string{},
+		},
+		{
+			Name:   "convertible number",
+			Src:    `foo = 1`,
+			Schema: AttributeSchema{Name: "foo", Type: cty.String},
+			Want:   []string{},
+		},
+		{
+			Name:   "type mismatch",
+			Src:    `foo = "bar"`,
+			Schema: AttributeSchema{Name: "foo", Type: cty.Number},
+			Want:   []string{`test.tf:1,7-12: Incorrect attribute value type; Inappropriate value for attribute "foo": a number is required.`},
+		},
+		{
+			Name:   "collection type mismatch",
+			Src:    `foo = ["bar", {}]`,
+			Schema: AttributeSchema{Name: "foo", Type: cty.List(cty.String)},
+			Want:   []string{`test.tf:1,7-18: Incorrect attribute value type; Inappropriate value for attribute "foo": element 1: string required, but have object.`},
+		},
+		{
+			Name:   "null",
+			Src:    `foo = null`,
+			Schema: AttributeSchema{Name: "foo", Type: cty.Number},
+			Want:   []string{},
+		},
+		{
+			Name:   "variable",
+			Src:    `foo = var.foo`,
+			Schema: AttributeSchema{Name: "foo", Type: cty.Number},
+			Want:   []string{},
+		},
+		{
+			Name:   "function call",
+			Src:    `foo = upper("bar")`,
+			Schema: AttributeSchema{Name: "foo", Type: cty.Number},
+			Want:   []string{},
+		},
+		{
+			Name:   "literal only",
+			Src:    `foo = [1, 2]`,
+			Schema: AttributeSchema{Name: "foo", LiteralOnly: true},
+			Want:   []string{},
+		},
+		{
+			Name:   "variable in literal only",
+			Src:    `foo = var.foo`,
+			Schema: AttributeSchema{Name: "foo", Type: cty.Number, LiteralOnly: true},
+			Want:   []string{`test.tf:1,7-14: Invalid expression; The value of "foo" must be a literal value. Variables and functions are not allowed here.`},
+		},
+		{
+			Name:   "function call in literal only",
+			Src:    `foo = upper("bar")`,
+			Schema: AttributeSchema{Name: "foo", LiteralOnly: true},
+			Want:   []string{`test.tf:1,7-19: Invalid expression; The value of "foo" must be a literal value. Variables and functions are not allowed here.`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			file, diags := hclsyntax.ParseConfig([]byte(test.Src), "test.tf", hcl.InitialPos)
+			if diags.HasErrors() {
+				t.Fatal(diags)
+			}
+			schema := &BodySchema{Attributes: []AttributeSchema{test.Schema}}
+
+			for _, partial := range []bool{false, true} {
+				var content *BodyContent
+				if partial {
+					content, diags = PartialContent(file.Body, schema)
+				} else {
+					content, diags = Content(file.Body, schema)
+				}
+
+				got := []string{}
+				for _, diag := range diags {
+					got = append(got, diag.Error())
+				}
+				if diff := cmp.Diff(test.Want, got); diff != "" {
+					t.Errorf("partial=%t: %s", partial, diff)
+				}
+				if _, exists := content.Attributes["foo"]; !exists {
+					t.Errorf("partial=%t: attribute should be retrieved even if it is invalid", partial)
+				}
+			}
+		})
+	}
+}
+
+func TestContent_RecursiveMode(t *testing.T) {
+	src := `
+foo = 1
+
+resource "aws_wafv2_web_acl" "main" {
+  name = "main"
+
+  rule {
+    name = "rule-1"
+
+    statement {
+      and_statement {
+        statement {
+          byte_match_statement {
+            search_string = "foo"
+          }
+        }
+      }
+    }
+  }
+
+  dynamic "rule" {
+    for_each = var.rules
+    content {
+      name = rule.value.name
+    }
+  }
+}
+`
+
+	tests := []struct {
+		Name   string
+		Schema *BodySchema
+		Want   string
+		Diags  []string
+	}{
+		{
+			Name:   "unlimited",
+			Schema: &BodySchema{Mode: SchemaRecursiveMode},
+			Want:   `foo resource[aws_wafv2_web_acl,main]{name rule[]{name statement[]{and_statement[]{statement[]{byte_match_statement[]{search_string}}}}} dynamic[rule]{for_each content[]{name}}}`,
+			Diags:  []string{},
+		},
+		{
+			Name:   "depth",
+			Schema: &BodySchema{Mode: SchemaRecursiveMode, Depth: 2},
+			Want:   `foo resource[aws_wafv2_web_acl,main]{name rule[]{name} dynamic[rule]{for_each}}`,
+			Diags:  []string{},
+		},
+		{
+			Name: "declared blocks",
+			Schema: &BodySchema{
+				Mode: SchemaRecursiveMode,
+				Blocks: []BlockSchema{
+					{
+						Type:       "resource",
+						LabelNames: []string{"type", "name"},
+						Body: &BodySchema{
+							Mode: SchemaRecursiveMode,
+							Blocks: []BlockSchema{
+								{
+									Type: "rule",
+									Body: &BodySchema{Mode: SchemaRecursiveMode, Depth: 1},
+								},
+							},
+						},
+					},
+				},
+			},
+			Want:  `foo resource[aws_wafv2_web_acl,main]{name rule[]{name statement[]{}} dynamic[rule]{for_each content[]{name}}}`,
+			Diags: []string{},
+		},
+		{
+			Name: "declared attributes",
+			Schema: &BodySchema{
+				Mode:       SchemaRecursiveMode,
+				Attributes: []AttributeSchema{{Name: "foo", Type: cty.Bool}, {Name: "bar", Required: true}},
+				Depth:      1,
+			},
+			Want: `foo resource[aws_wafv2_web_acl,main]{name}`,
+			Diags: []string{
+				`test.tf:1,1-1: Missing required argument; The argument "bar" is required, but no definition was found.`,
+				`test.tf:2,7-8: Incorrect attribute value type; Inappropriate value for attribute "foo": bool required, but have number.`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			file, diags := hclsyntax.ParseConfig([]byte(src), "test.tf", hcl.InitialPos)
+			if diags.HasErrors() {
+				t.Fatal(diags)
+			}
+
+			content, diags := Content(file.Body, test.Schema)
+			if diff := cmp.Diff(test.Want, dumpContent(content)); diff != "" {
+				t.Error(diff)
+			}
+			got := make([]string, len(diags))
+			for i, diag := range diags {
+				got[i] = diag.Error()
+			}
+			sort.Strings(got)
+			if diff := cmp.Diff(test.Diags, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestContent_RecursiveModeLabels(t *testing.T) {
+	src := `
+block "a" {
+  nested {}
+  nested "b" "c" {}
+}
+
+variable "foo" {}
+
+block "a" "b" {
+  nested "d" {}
+}
+`
+	file, diags := hclsyntax.ParseConfig([]byte(src), "test.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+
+	// Blocks of the same type can have a different number of labels.
+	content, diags := Content(file.Body, &BodySchema{
+		Mode:   SchemaRecursiveMode,
+		Blocks: []BlockSchema{{Type: "variable", LabelNames: []string{"name"}}},
+	})
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	want := `block[a]{nested[]{} nested[b,c]{}} variable[foo]{} block[a,b]{nested[d]{}}`
+	if diff := cmp.Diff(want, dumpContent(content)); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestValidateContent(t *testing.T) {
+	src := `
+foo = "bar"
+baz = var.baz
+
+resource "aws_instance" "main" {
+  count = "two"
+}
+`
+	file, diags := hclsyntax.ParseConfig([]byte(src), "test.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	schema := &BodySchema{
+		Attributes: []AttributeSchema{{Name: "foo", Type: cty.Bool}, {Name: "baz", LiteralOnly: true}, {Name: "qux", Type: cty.Number}},
+		Blocks: []BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body:       &BodySchema{Attributes: []AttributeSchema{{Name: "count", Type: cty.Number}}},
+			},
+		},
+	}
+	// Retrieve the content without constraints, like hosts that ignore them.
+	content, diags := Content(file.Body, &BodySchema{
+		Attributes: []AttributeSchema{{Name: "foo"}, {Name: "baz"}, {Name: "qux"}},
+		Blocks: []BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body:       &BodySchema{Attributes: []AttributeSchema{{Name: "count"}}},
+			},
+		},
+	})
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+
+	diags = ValidateContent(content, schema)
+	got := make([]string, len(diags))
+	for i, diag := range diags {
+		got[i] = diag.Error()
+	}
+	sort.Strings(got)
+	want := []string{
+		`test.tf:2,7-12: Incorrect attribute value type; Inappropriate value for attribute "foo": a bool is required.`,
+		`test.tf:3,7-14: Invalid expression; The value of "baz" must be a literal value. Variables and functions are not allowed here.`,
+		`test.tf:6,11-16: Incorrect attribute value type; Inappropriate value for attribute "count": a number is required.`,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestContent_RecursiveModeJSON(t *testing.T) {
+	file, diags := json.Parse([]byte(`{"foo": 1, "resource": {"aws_instance": {"main": {"ami": "ami-123456"}}}}`), "test.tf.json")
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+
+	// Only declared attributes and blocks are extracted from JSON bodies.
+	content, diags := Content(file.Body, &BodySchema{
+		Mode:       SchemaRecursiveMode,
+		Attributes: []AttributeSchema{{Name: "foo"}},
+	})
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	if diff := cmp.Diff("foo", dumpContent(content)); diff != "" {
+		t.Error(diff)
+	}
+}
+
+// dumpContent returns a compact representation of the body content,
+// like "attr type[label1,label2]{attr}". Attributes are sorted by name.
+func dumpContent(content *BodyContent) string {
+	names := make([]string, 0, len(content.Attributes))
+	for name := range content.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, block := range content.Blocks {
+		names = append(names, fmt.Sprintf("%s[%s]{%s}", block.Type, strings.Join(block.Labels, ","), dumpContent(block.Body)))
+	}
+	return strings.Join(names, " ")
+}
+
 func TestContent_JustAttributes(t *testing.T) {
 	tests := []struct {
 		Name      string
@@ -948,6 +1281,73 @@ func TestWalkAttributes(t *testing.T) {
 	}
 }
 
+func TestSortBlocks(t *testing.T) {
+	rng := func(filename string, offset int) hcl.Range {
+		return hcl.Range{Filename: filename, Start: hcl.Pos{Byte: offset}}
+	}
+
+	body := &BodyContent{
+		Blocks: Blocks{
+			{Type: "d", DefRange: rng("main.tf", 10), Body: &BodyContent{}},
+			{Type: "b", DefRange: rng("b.tf", 10), Body: &BodyContent{}},
+			{
+				Type:     "c",
+				DefRange: rng("main.tf", 0),
+				Body: &BodyContent{
+					Blocks: Blocks{
+						{Type: "c2", DefRange: rng("main.tf", 5), Body: &BodyContent{}},
+						{Type: "c1", DefRange: rng("main.tf", 3), Body: &BodyContent{}},
+					},
+				},
+			},
+			{Type: "a", DefRange: rng("b.tf", 0), Body: &BodyContent{}},
+			{Type: "d2", DefRange: rng("main.tf", 10), Body: &BodyContent{}},
+		},
+	}
+	blocks := body.Blocks.Sorted()
+	body.SortBlocks()
+
+	types := func(blocks Blocks) []string {
+		ret := make([]string, len(blocks))
+		for i, block := range blocks {
+			ret[i] = block.Type
+		}
+		return ret
+	}
+	want := []string{"a", "b", "c", "d", "d2"}
+	if diff := cmp.Diff(want, types(blocks)); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(want, types(body.Blocks)); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff([]string{"c1", "c2"}, types(body.Blocks[2].Body.Blocks)); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestSorted_Attributes(t *testing.T) {
+	rng := func(filename string, offset int) hcl.Range {
+		return hcl.Range{Filename: filename, Start: hcl.Pos{Byte: offset}}
+	}
+
+	attrs := Attributes{
+		"foo": {Name: "foo", Range: rng("main.tf", 10)},
+		"bar": {Name: "bar", Range: rng("main.tf", 0)},
+		"baz": {Name: "baz", Range: rng("a.tf", 20)},
+		"qux": {Name: "qux"},
+		"aaa": {Name: "aaa"},
+	}
+
+	got := []string{}
+	for _, attr := range attrs.Sorted() {
+		got = append(got, attr.Name)
+	}
+	if diff := cmp.Diff([]string{"aaa", "qux", "baz", "bar", "foo"}, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
 func TestCopy_Attribute(t *testing.T) {
 	attribute := &Attribute{
 		Name:      "foo",
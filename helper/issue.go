@@ -11,7 +11,32 @@ type Issue struct {
 	Rule    tflint.Rule
 	Message string
 	Range   hcl.Range
+	// Fixes are the alternative fixes emitted with the issue.
+	// These are ignored by AssertIssues. Use AssertIssuesWithFixes to compare them.
+	Fixes []*Fix
+}
+
+// Fix is an alternative fix of the issue.
+// Changes are the formatted contents of the files changed by the fix.
+type Fix struct {
+	Name    string
+	Changes map[string]string
 }
 
 // Issues is a list of Issue.
 type Issues []*Issue
+
+// Conflict is a fix that was not applied because it conflicts with the fix of a previous issue.
+type Conflict struct {
+	// Issue is the issue that was not fixed by the fix.
+	Issue *Issue
+	// Fix is the name of the fix.
+	Fix string
+	// With is the previous issue whose fix was applied instead.
+	With *Issue
+	// Reason is the error message of the conflict.
+	Reason string
+}
+
+// Conflicts is a list of Conflict.
+type Conflicts []*Conflict
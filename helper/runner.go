@@ -4,13 +4,15 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"reflect"
+	"sort"
 
+	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/internal"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/terraform/addrs"
 	"github.com/terraform-linters/tflint-plugin-sdk/terraform/lang/marks"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
@@ -22,12 +24,20 @@ import (
 // Runner is a mock that satisfies the Runner interface for plugin testing.
 type Runner struct {
 	Issues Issues
+	// Conflicts is a report of fixes that were not applied because of conflicts
+	// with the fixes of previous issues.
+	Conflicts Conflicts
+	// FormatMode is how files changed by fixes are formatted, like TFLint's global config.
+	// Fixes of rules that opt out of formatting are not formatted regardless of this mode.
+	FormatMode tflint.FormatMode
 
 	files     map[string]*hcl.File
 	sources   map[string][]byte
 	config    Config
 	variables map[string]*Variable
 	fixer     *internal.Fixer
+	// fixedBy is a map of the ranges of applied edits to the issue fixed by them.
+	fixedBy map[hcl.Range]*Issue
 }
 
 // Variable is an implementation of variables in Terraform language
@@ -61,7 +71,9 @@ func (r *Runner) GetModulePath() (addrs.Module, error) {
 	return []string{}, nil
 }
 
-// GetModuleContent gets a content of the current module
+// GetModuleContent gets a content of the current module.
+// Blocks are returned as written without being expanded by `count`, `for_each`, and `dynamic`,
+// so hclext.Block.Instance is always nil regardless of the ExpandMode.
 func (r *Runner) GetModuleContent(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
 	content := &hclext.BodyContent{
 		Attributes: hclext.Attributes{},
@@ -69,8 +81,8 @@ func (r *Runner) GetModuleContent(schema *hclext.BodySchema, opts *tflint.GetMod
 	}
 	diags := hcl.Diagnostics{}
 
-	for _, f := range r.files {
-		c, d := hclext.PartialContent(f.Body, schema)
+	for _, name := range r.sortedFilenames() {
+		c, d := hclext.PartialContent(r.files[name].Body, schema)
 		diags = diags.Extend(d)
 		for name, attr := range c.Attributes {
 			content.Attributes[name] = attr
@@ -81,6 +93,7 @@ func (r *Runner) GetModuleContent(schema *hclext.BodySchema, opts *tflint.GetMod
 	if diags.HasErrors() {
 		return nil, diags
 	}
+	content.SortBlocks()
 	return content, nil
 }
 
@@ -161,7 +174,8 @@ func (w *nativeWalker) Exit(node hclsyntax.Node) hcl.Diagnostics {
 // WalkExpressions traverses expressions in all files by the passed walker.
 func (r *Runner) WalkExpressions(walker tflint.ExprWalker) hcl.Diagnostics {
 	diags := hcl.Diagnostics{}
-	for _, file := range r.files {
+	for _, name := range r.sortedFilenames() {
+		file := r.files[name]
 		if body, ok := file.Body.(*hclsyntax.Body); ok {
 			walkDiags := hclsyntax.Walk(body, &nativeWalker{walker: walker})
 			diags = diags.Extend(walkDiags)
@@ -175,7 +189,13 @@ func (r *Runner) WalkExpressions(walker tflint.ExprWalker) hcl.Diagnostics {
 			continue
 		}
 
+		sorted := make([]*hcl.Attribute, 0, len(attrs))
 		for _, attr := range attrs {
+			sorted = append(sorted, attr)
+		}
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i].Range.Start.Byte < sorted[j].Range.Start.Byte })
+
+		for _, attr := range sorted {
 			enterDiags := walker.Enter(attr.Expr)
 			diags = diags.Extend(enterDiags)
 			exitDiags := walker.Exit(attr.Expr)
@@ -206,56 +226,20 @@ func (r *Runner) DecodeRuleConfig(name string, ret interface{}) error {
 	return nil
 }
 
-var errRefTy = reflect.TypeOf((*error)(nil)).Elem()
-
 // EvaluateExpr returns a value of the passed expression.
 // Note that some features are limited
 func (r *Runner) EvaluateExpr(expr hcl.Expression, target interface{}, opts *tflint.EvaluateExprOption) error {
-	rval := reflect.ValueOf(target)
-	rty := rval.Type()
-
-	var callback bool
-	switch rty.Kind() {
-	case reflect.Func:
-		// Callback must meet the following requirements:
-		//   - It must be a function
-		//   - It must take an argument
-		//   - It must return an error
-		if !(rty.NumIn() == 1 && rty.NumOut() == 1 && rty.Out(0).Implements(errRefTy)) {
-			panic(`callback must be of type "func (v T) error"`)
-		}
-		callback = true
-		target = reflect.New(rty.In(0)).Interface()
-
-	case reflect.Pointer:
-		// ok
-	default:
-		panic("target value is not a pointer or function")
-	}
-
-	err := r.evaluateExpr(expr, target, opts)
-	if !callback {
-		// error should be handled in the caller
-		return err
-	}
-
-	if err != nil {
-		// If it cannot be represented as a Go value, exit without invoking the callback rather than returning an error.
-		if errors.Is(err, tflint.ErrUnknownValue) ||
-			errors.Is(err, tflint.ErrNullValue) ||
-			errors.Is(err, tflint.ErrSensitive) ||
-			errors.Is(err, tflint.ErrEphemeral) ||
-			errors.Is(err, tflint.ErrUnevaluable) {
-			return nil
-		}
-		return err
-	}
+	target, complete := internal.PrepareTarget(target)
+	return complete(r.evaluateExpr(expr, target, opts))
+}
 
-	rerr := rval.Call([]reflect.Value{reflect.ValueOf(target).Elem()})
-	if rerr[0].IsNil() {
-		return nil
+// EvaluateExprs evaluates the passed expressions one by one.
+func (r *Runner) EvaluateExprs(requests []tflint.EvaluateExprRequest) ([]error, error) {
+	errs := make([]error, len(requests))
+	for i, req := range requests {
+		errs[i] = r.EvaluateExpr(req.Expr, req.Target, req.Option)
 	}
-	return rerr[0].Interface().(error)
+	return errs, nil
 }
 
 func (r *Runner) evaluateExpr(expr hcl.Expression, target interface{}, opts *tflint.EvaluateExprOption) error {
@@ -268,28 +252,17 @@ func (r *Runner) evaluateExpr(expr hcl.Expression, target interface{}, opts *tfl
 		ty = *opts.WantType
 	} else {
 		switch target.(type) {
-		case *string:
-			ty = cty.String
-		case *int:
-			ty = cty.Number
-		case *bool:
-			ty = cty.Bool
-		case *[]string:
-			ty = cty.List(cty.String)
-		case *[]int:
-			ty = cty.List(cty.Number)
-		case *[]bool:
-			ty = cty.List(cty.Bool)
-		case *map[string]string:
-			ty = cty.Map(cty.String)
-		case *map[string]int:
-			ty = cty.Map(cty.Number)
-		case *map[string]bool:
-			ty = cty.Map(cty.Bool)
 		case *cty.Value:
 			ty = cty.DynamicPseudoType
+		case *tflint.PartialValue:
+			ty = cty.DynamicPseudoType
 		default:
-			return fmt.Errorf("unsupported target type: %T", target)
+			// Infer the type from the Go type, such as *string, *[]int, and structs with cty tags.
+			var err error
+			ty, err = internal.ImpliedType(target)
+			if err != nil {
+				return fmt.Errorf("unsupported target type: %T", target)
+			}
 		}
 	}
 
@@ -317,6 +290,12 @@ func (r *Runner) evaluateExpr(expr hcl.Expression, target interface{}, opts *tfl
 		return err
 	}
 
+	// PartialValue receives the value as it is, including unknown and marked values.
+	if partial, ok := target.(*tflint.PartialValue); ok {
+		*partial = *tflint.NewPartialValue(val)
+		return nil
+	}
+
 	if ty == cty.DynamicPseudoType {
 		return gocty.FromCtyValue(val, target)
 	}
@@ -328,6 +307,10 @@ func (r *Runner) evaluateExpr(expr hcl.Expression, target interface{}, opts *tfl
 			return false, tflint.ErrUnknownValue
 		}
 		if v.IsNull() {
+			if internal.IsNullable(target, path) {
+				// Nullable fields like *string can receive null values
+				return false, nil
+			}
 			return false, tflint.ErrNullValue
 		}
 		if v.HasMark(marks.Sensitive) {
@@ -342,7 +325,21 @@ func (r *Runner) evaluateExpr(expr hcl.Expression, target interface{}, opts *tfl
 		return err
 	}
 
-	return gocty.FromCtyValue(val, target)
+	return internal.FromCtyValue(val, target)
+}
+
+// TraceValue traces the value of the passed expression through local values and variables.
+func (r *Runner) TraceValue(expr hcl.Expression) (*tflint.ValueSource, error) {
+	source, diags := internal.TraceValue(r.files, expr)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return source, nil
+}
+
+// Logger returns the global logger. Logs in tests are not tagged with the rule and module.
+func (r *Runner) Logger() hclog.Logger {
+	return logger.Logger()
 }
 
 // EmitIssue adds an issue to the runner itself.
@@ -357,18 +354,60 @@ func (r *Runner) EmitIssue(rule tflint.Rule, message string, location hcl.Range)
 
 // EmitIssueWithFix adds an issue and invoke fix.
 func (r *Runner) EmitIssueWithFix(rule tflint.Rule, message string, location hcl.Range, fixFunc func(f tflint.Fixer) error) error {
-	r.fixer.StashChanges()
-	if err := fixFunc(r.fixer); err != nil {
-		if errors.Is(err, tflint.ErrFixNotSupported) {
-			r.fixer.PopChangesFromStash()
-			return r.EmitIssue(rule, message, location)
+	return r.EmitIssueWithFixes(rule, message, location, []tflint.Fix{{Func: fixFunc}})
+}
+
+// EmitIssueWithFixes adds an issue with alternative fixes and invoke them.
+// The changes of each fix are recorded in the issue, and the changes of the default fix
+// are also added to the runner itself. Fixes that conflict with the fixes of previous issues
+// are recorded in Conflicts instead. Alternative fixes that return an error are skipped,
+// and only an error from the default fix is returned.
+func (r *Runner) EmitIssueWithFixes(rule tflint.Rule, message string, location hcl.Range, fixes []tflint.Fix) error {
+	issue := &Issue{
+		Rule:    rule,
+		Message: message,
+		Range:   location,
+	}
+
+	formatMode := r.FormatMode
+	if !rule.FormatFixes() {
+		formatMode = tflint.FormatModeNone
+	}
+	r.fixer.SetFormatMode(formatMode)
+	results := internal.OfferedFixes(r.fixer.RunFixes(fixes))
+	for _, result := range results {
+		if result.Conflict != nil {
+			r.Conflicts = append(r.Conflicts, &Conflict{
+				Issue:  issue,
+				Fix:    result.Name,
+				With:   r.fixedBy[result.Conflict.Edit.Range],
+				Reason: result.Conflict.Error(),
+			})
+			continue
+		}
+		if result.Err != nil {
+			// Only the default fix remains with an error. See internal.OfferedFixes.
+			return result.Err
+		}
+
+		changes := map[string]string{}
+		for filename, content := range result.Changes {
+			changes[filename] = string(content)
+		}
+		issue.Fixes = append(issue.Fixes, &Fix{Name: result.Name, Changes: changes})
+	}
+	if defaultFix := internal.DefaultFix(results); defaultFix != nil {
+		for _, edit := range defaultFix.Edits {
+			r.fixedBy[edit.Range] = issue
 		}
-		return err
 	}
-	return r.EmitIssue(rule, message, location)
+
+	r.Issues = append(r.Issues, issue)
+	return nil
 }
 
 // Changes returns formatted changes by the fixer.
+// Each file is formatted according to the format mode at the time of the fixes that changed it.
 func (r *Runner) Changes() map[string][]byte {
 	r.fixer.FormatChanges()
 	return r.fixer.Changes()
@@ -388,13 +427,25 @@ func (r *Runner) EnsureNoError(err error, proc func() error) error {
 	return err
 }
 
+// sortedFilenames returns filenames in the module in order so that results are deterministic.
+func (r *Runner) sortedFilenames() []string {
+	names := make([]string, 0, len(r.files))
+	for name := range r.files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // newLocalRunner initialises a new test runner.
 func newLocalRunner(files map[string]*hcl.File, issues Issues) *Runner {
 	return &Runner{
 		files:     map[string]*hcl.File{},
 		sources:   map[string][]byte{},
 		variables: map[string]*Variable{},
+		fixedBy:   map[hcl.Range]*Issue{},
 		Issues:    issues,
+		Conflicts: Conflicts{},
 	}
 }
 
@@ -3,6 +3,7 @@ package helper
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -333,6 +334,77 @@ func Test_GetModuleContent_json(t *testing.T) {
 	}
 }
 
+func Test_GetModuleContent_order(t *testing.T) {
+	files := map[string]string{
+		"main.tf": `
+resource "aws_instance" "b" {}
+resource "aws_instance" "a" {}
+`,
+		"a.tf": `
+resource "aws_instance" "c" {}
+`,
+		"z.tf": `
+resource "aws_instance" "d" {}
+`,
+		"b.tf": `
+resource "aws_instance" "e" {}
+`,
+	}
+
+	runner := TestRunner(t, files)
+
+	// Run several times because map iteration order is random.
+	for i := 0; i < 10; i++ {
+		got, err := runner.GetResourceContent("aws_instance", &hclext.BodySchema{}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		names := make([]string, len(got.Blocks))
+		for i, block := range got.Blocks {
+			names[i] = block.Labels[1]
+		}
+		if diff := cmp.Diff([]string{"c", "e", "b", "a", "d"}, names); diff != "" {
+			t.Fatal(diff)
+		}
+	}
+}
+
+func Test_GetModuleContent_notExpanded(t *testing.T) {
+	files := map[string]string{
+		"main.tf": `
+resource "aws_instance" "foo" {
+  count = 2
+
+  dynamic "ebs_block_device" {
+    for_each = ["a", "b"]
+    content {}
+  }
+}`,
+	}
+
+	runner := TestRunner(t, files)
+
+	// The test runner does not expand blocks, so instances are not available.
+	got, err := runner.GetResourceContent("aws_instance", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{{Type: "dynamic", LabelNames: []string{"name"}, Body: &hclext.BodySchema{}}},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeExpand})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got.Blocks) != 1 {
+		t.Fatalf("got %d blocks, but 1 block is expected", len(got.Blocks))
+	}
+	resource := got.Blocks[0]
+	if resource.Instance != nil || resource.Instance.HasValue() {
+		t.Errorf("unexpected instance: %#v", resource.Instance)
+	}
+	if len(resource.Body.Blocks) != 1 || resource.Body.Blocks[0].Instance != nil {
+		t.Errorf("unexpected nested blocks: %#v", resource.Body.Blocks)
+	}
+}
+
 func TestWalkExpressions(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -863,6 +935,201 @@ resource "aws_instance" "foo" {
 	}
 }
 
+func Test_EvaluateExprs(t *testing.T) {
+	src := `
+variable "unknown" {}
+
+resource "aws_instance" "foo" {
+  instance_type = "t2.micro"
+}
+
+resource "aws_instance" "bar" {
+  instance_type = var.unknown
+}`
+
+	runner := TestRunner(t, map[string]string{"main.tf": src})
+
+	resources, err := runner.GetResourceContent("aws_instance", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "instance_type"}},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	instanceTypes := make([]string, len(resources.Blocks))
+	requests := make([]tflint.EvaluateExprRequest, len(resources.Blocks))
+	for i, resource := range resources.Blocks {
+		requests[i] = tflint.EvaluateExprRequest{Expr: resource.Body.Attributes["instance_type"].Expr, Target: &instanceTypes[i]}
+	}
+
+	errs, err := runner.EvaluateExprs(requests)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if errs[0] != nil || instanceTypes[0] != "t2.micro" {
+		t.Errorf("foo: value=%s, err=%s", instanceTypes[0], errs[0])
+	}
+	if !errors.Is(errs[1], tflint.ErrUnknownValue) {
+		t.Errorf("bar: expected ErrUnknownValue, but got %s", errs[1])
+	}
+}
+
+func Test_EvaluateExpr_struct(t *testing.T) {
+	type ingress struct {
+		FromPort    int            `cty:"from_port"`
+		ToPort      int            `cty:"to_port"`
+		CIDRBlocks  []string       `cty:"cidr_blocks"`
+		Description *string        `cty:"description"`
+		Timeout     *time.Duration `cty:"timeout"`
+	}
+
+	src := `
+resource "aws_security_group" "foo" {
+  ingress = [
+    {
+      from_port   = 80
+      to_port     = 80
+      cidr_blocks = ["0.0.0.0/0"]
+      description = "HTTP"
+      timeout     = "30s"
+    },
+    {
+      from_port   = 443
+      to_port     = 443
+      cidr_blocks = []
+      description = null
+    },
+  ]
+}`
+
+	runner := TestRunner(t, map[string]string{"main.tf": src})
+
+	resources, err := runner.GetResourceContent("aws_security_group", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "ingress"}},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	description := "HTTP"
+	timeout := 30 * time.Second
+	want := []ingress{
+		{FromPort: 80, ToPort: 80, CIDRBlocks: []string{"0.0.0.0/0"}, Description: &description, Timeout: &timeout},
+		{FromPort: 443, ToPort: 443, CIDRBlocks: []string{}},
+	}
+
+	for _, resource := range resources.Blocks {
+		var got []ingress
+		if err := runner.EvaluateExpr(resource.Body.Attributes["ingress"].Expr, &got, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Error(diff)
+		}
+	}
+}
+
+func Test_EvaluateExpr_partial(t *testing.T) {
+	src := `
+variable "env" {}
+
+variable "owner" {
+  default   = "secret"
+  sensitive = true
+}
+
+resource "aws_instance" "foo" {
+  tags = {
+    Name  = "foo"
+    Env   = var.env
+    Owner = var.owner
+  }
+}`
+
+	runner := TestRunner(t, map[string]string{"main.tf": src})
+
+	resources, err := runner.GetResourceContent("aws_instance", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "tags"}},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, resource := range resources.Blocks {
+		var tags tflint.PartialValue
+		if err := runner.EvaluateExpr(resource.Body.Attributes["tags"].Expr, &tags, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		if tags.IsWhollyKnown() {
+			t.Fatal("expected the value to be partially unknown")
+		}
+		if got := tags.Value.GetAttr("Name"); !got.RawEquals(cty.StringVal("foo")) {
+			t.Errorf("unexpected Name: %#v", got)
+		}
+
+		opts := cmp.Options{
+			cmp.Comparer(func(x, y cty.Path) bool { return x.Equals(y) }),
+		}
+		if diff := cmp.Diff([]cty.Path{cty.GetAttrPath("Env")}, tags.UnknownPaths, opts); diff != "" {
+			t.Errorf("unknown paths: %s", diff)
+		}
+		if diff := cmp.Diff([]cty.Path{cty.GetAttrPath("Owner")}, tags.SensitivePaths, opts); diff != "" {
+			t.Errorf("sensitive paths: %s", diff)
+		}
+		if diff := cmp.Diff([]cty.Path{}, tags.EphemeralPaths, opts); diff != "" {
+			t.Errorf("ephemeral paths: %s", diff)
+		}
+	}
+}
+
+func Test_TraceValue(t *testing.T) {
+	runner := TestRunner(t, map[string]string{
+		"main.tf": `
+variable "size" {
+  default = "micro"
+}
+
+locals {
+  instance_type = "t2.${var.size}"
+}
+
+resource "aws_instance" "foo" {
+  instance_type = local.instance_type
+}`,
+	})
+
+	resources, err := runner.GetResourceContent("aws_instance", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "instance_type"}},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	source, err := runner.TraceValue(resources.Blocks[0].Body.Attributes["instance_type"].Expr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	origins := source.Origins()
+	if len(origins) != 1 {
+		t.Fatalf("origins should be 1, but got %d", len(origins))
+	}
+	want := hcl.Range{
+		Filename: "main.tf",
+		Start:    hcl.Pos{Line: 3, Column: 13, Byte: 31},
+		End:      hcl.Pos{Line: 3, Column: 20, Byte: 38},
+	}
+	if diff := cmp.Diff(want, origins[0].Expr.Range()); diff != "" {
+		t.Errorf("diff: %s", diff)
+	}
+	if origins[0].Ref.Subject.String() != "var.size" {
+		t.Errorf("origin should be var.size, but got %s", origins[0].Ref.Subject)
+	}
+}
+
 type dummyRule struct {
 	tflint.DefaultRule
 }
@@ -872,6 +1139,12 @@ func (r *dummyRule) Enabled() bool             { return true }
 func (r *dummyRule) Severity() tflint.Severity { return tflint.ERROR }
 func (r *dummyRule) Check(tflint.Runner) error { return nil }
 
+type unformattedRule struct {
+	dummyRule
+}
+
+func (r *unformattedRule) FormatFixes() bool { return false }
+
 func Test_EmitIssue(t *testing.T) {
 	src := `
 resource "aws_instance" "foo" {
@@ -946,6 +1219,16 @@ resource "aws_instance" "foo" {
 					Rule:    &dummyRule{},
 					Message: "issue found",
 					Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 3, Column: 19}, End: hcl.Pos{Line: 3, Column: 29}},
+					Fixes: []*Fix{
+						{
+							Changes: map[string]string{
+								"main.tf": `
+resource "aws_instance" "foo" {
+  instance_type = "t3.micro"
+}`,
+							},
+						},
+					},
 				},
 			},
 			fixed: `
@@ -1042,6 +1325,218 @@ resource "aws_instance" "foo" {
 	}
 }
 
+func Test_EmitIssueWithFixes(t *testing.T) {
+	src := `
+resource "aws_instance" "foo" {
+  instance_type = "t2.micro"
+}`
+	rng := hcl.Range{
+		Filename: "main.tf",
+		Start:    hcl.Pos{Line: 3, Column: 19, Byte: 51},
+		End:      hcl.Pos{Line: 3, Column: 29, Byte: 61},
+	}
+	replace := func(text string) func(tflint.Fixer) error {
+		return func(fixer tflint.Fixer) error {
+			return fixer.ReplaceText(rng, text)
+		}
+	}
+	notSupported := func(tflint.Fixer) error { return tflint.ErrFixNotSupported }
+	failing := func(fixer tflint.Fixer) error {
+		if err := fixer.ReplaceText(rng, `"t3.large"`); err != nil {
+			return err
+		}
+		return errors.New("unexpected error")
+	}
+
+	tests := []struct {
+		name  string
+		fixes []tflint.Fix
+		want  Issues
+		fixed map[string]string
+	}{
+		{
+			name: "multiple fixes",
+			fixes: []tflint.Fix{
+				{Name: "Use t3.micro", Func: replace(`"t3.micro"`)},
+				{Name: "Not supported", Func: notSupported},
+				{Name: "Use t3.small", Func: replace(`"t3.small"`)},
+			},
+			want: Issues{
+				{
+					Rule:    &dummyRule{},
+					Message: "issue found",
+					Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 3, Column: 19}, End: hcl.Pos{Line: 3, Column: 29}},
+					Fixes: []*Fix{
+						{
+							Name: "Use t3.micro",
+							Changes: map[string]string{
+								"main.tf": `
+resource "aws_instance" "foo" {
+  instance_type = "t3.micro"
+}`,
+							},
+						},
+						{
+							Name: "Use t3.small",
+							Changes: map[string]string{
+								"main.tf": `
+resource "aws_instance" "foo" {
+  instance_type = "t3.small"
+}`,
+							},
+						},
+					},
+				},
+			},
+			fixed: map[string]string{
+				"main.tf": `
+resource "aws_instance" "foo" {
+  instance_type = "t3.micro"
+}`,
+			},
+		},
+		{
+			name: "default fix is not supported",
+			fixes: []tflint.Fix{
+				{Name: "Not supported", Func: notSupported},
+				{Name: "Use t3.small", Func: replace(`"t3.small"`)},
+			},
+			want: Issues{
+				{
+					Rule:    &dummyRule{},
+					Message: "issue found",
+					Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 3, Column: 19}, End: hcl.Pos{Line: 3, Column: 29}},
+					Fixes: []*Fix{
+						{
+							Name: "Use t3.small",
+							Changes: map[string]string{
+								"main.tf": `
+resource "aws_instance" "foo" {
+  instance_type = "t3.small"
+}`,
+							},
+						},
+					},
+				},
+			},
+			fixed: map[string]string{
+				"main.tf": `
+resource "aws_instance" "foo" {
+  instance_type = "t3.small"
+}`,
+			},
+		},
+		{
+			name: "alternative fix fails",
+			fixes: []tflint.Fix{
+				{Name: "Use t3.micro", Func: replace(`"t3.micro"`)},
+				{Name: "Use t3.large", Func: failing},
+			},
+			want: Issues{
+				{
+					Rule:    &dummyRule{},
+					Message: "issue found",
+					Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 3, Column: 19}, End: hcl.Pos{Line: 3, Column: 29}},
+					Fixes: []*Fix{
+						{
+							Name: "Use t3.micro",
+							Changes: map[string]string{
+								"main.tf": `
+resource "aws_instance" "foo" {
+  instance_type = "t3.micro"
+}`,
+							},
+						},
+					},
+				},
+			},
+			fixed: map[string]string{
+				"main.tf": `
+resource "aws_instance" "foo" {
+  instance_type = "t3.micro"
+}`,
+			},
+		},
+		{
+			name: "no fixes are supported",
+			fixes: []tflint.Fix{
+				{Name: "Not supported", Func: notSupported},
+			},
+			want: Issues{
+				{
+					Rule:    &dummyRule{},
+					Message: "issue found",
+					Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 3, Column: 19}, End: hcl.Pos{Line: 3, Column: 29}},
+				},
+			},
+			fixed: map[string]string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := TestRunner(t, map[string]string{"main.tf": src})
+
+			if err := runner.EmitIssueWithFixes(&dummyRule{}, "issue found", rng, test.fixes); err != nil {
+				t.Fatal(err)
+			}
+
+			AssertIssuesWithFixes(t, test.want, runner.Issues)
+			AssertChanges(t, test.fixed, runner.Changes())
+		})
+	}
+}
+
+func Test_EmitIssueWithFixes_conflicts(t *testing.T) {
+	src := `
+resource "aws_instance" "foo" {
+  instance_type = "t2.micro"
+}`
+	rng := hcl.Range{
+		Filename: "main.tf",
+		Start:    hcl.Pos{Line: 3, Column: 19, Byte: 51},
+		End:      hcl.Pos{Line: 3, Column: 29, Byte: 61},
+	}
+	replace := func(text string) func(tflint.Fixer) error {
+		return func(fixer tflint.Fixer) error {
+			return fixer.ReplaceText(rng, text)
+		}
+	}
+
+	runner := TestRunner(t, map[string]string{"main.tf": src})
+
+	if err := runner.EmitIssueWithFix(&dummyRule{}, "first issue", rng, replace(`"t3.micro"`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := runner.EmitIssueWithFixes(&dummyRule{}, "second issue", rng, []tflint.Fix{{Name: "Use t3.small", Func: replace(`"t3.small"`)}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(runner.Issues) != 2 {
+		t.Fatalf("want 2 issues, got %d", len(runner.Issues))
+	}
+	want := Conflicts{
+		{
+			Issue:  runner.Issues[1],
+			Fix:    "Use t3.small",
+			With:   runner.Issues[0],
+			Reason: "autofix conflicts with a previous fix: main.tf:3,19-29",
+		},
+	}
+	if diff := cmp.Diff(want, runner.Conflicts, cmpopts.IgnoreFields(Issue{}, "Rule")); diff != "" {
+		t.Error(diff)
+	}
+	if runner.Issues[1].Fixes != nil {
+		t.Errorf("conflicting fixes should not be recorded: %#v", runner.Issues[1].Fixes)
+	}
+	AssertChanges(t, map[string]string{
+		"main.tf": `
+resource "aws_instance" "foo" {
+  instance_type = "t3.micro"
+}`,
+	}, runner.Changes())
+}
+
 func TestChanges(t *testing.T) {
 	tests := []struct {
 		name string
@@ -1088,6 +1583,103 @@ locals {
 	}
 }
 
+func TestChanges_unformatted(t *testing.T) {
+	src := `
+locals {
+  foo = "bar"
+  baz = 1
+}`
+
+	runner := TestRunner(t, map[string]string{"main.tf": src})
+
+	if err := runner.EmitIssueWithFix(&unformattedRule{}, "issue found", hcl.Range{}, func(f tflint.Fixer) error {
+		return f.ReplaceText(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 12}, End: hcl.Pos{Byte: 15}}, "foobar")
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `
+locals {
+  foobar = "bar"
+  baz = 1
+}`
+	if diff := cmp.Diff(want, string(runner.Changes()["main.tf"])); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestChanges_formatModePerFile(t *testing.T) {
+	src := `
+locals {
+  foo = "bar"
+  baz = 1
+}`
+
+	runner := TestRunner(t, map[string]string{"unformatted.tf": src, "formatted.tf": src})
+
+	// The format mode of a later rule does not affect files changed by earlier rules.
+	if err := runner.EmitIssueWithFix(&unformattedRule{}, "issue found", hcl.Range{}, func(f tflint.Fixer) error {
+		return f.ReplaceText(hcl.Range{Filename: "unformatted.tf", Start: hcl.Pos{Byte: 12}, End: hcl.Pos{Byte: 15}}, "foobar")
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := runner.EmitIssueWithFix(&dummyRule{}, "issue found", hcl.Range{}, func(f tflint.Fixer) error {
+		return f.ReplaceText(hcl.Range{Filename: "formatted.tf", Start: hcl.Pos{Byte: 12}, End: hcl.Pos{Byte: 15}}, "foobar")
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"unformatted.tf": `
+locals {
+  foobar = "bar"
+  baz = 1
+}`,
+		"formatted.tf": `
+locals {
+  foobar = "bar"
+  baz    = 1
+}`,
+	}
+	AssertChanges(t, want, runner.Changes())
+}
+
+func TestChanges_changedBlocks(t *testing.T) {
+	src := `
+locals {
+  foo = "bar"
+  baz = 1
+}
+
+variable "foo" {
+  type = string
+  default = "bar"
+}`
+
+	runner := TestRunner(t, map[string]string{"main.tf": src})
+	runner.FormatMode = tflint.FormatModeChangedBlocks
+
+	if err := runner.EmitIssueWithFix(&dummyRule{}, "issue found", hcl.Range{}, func(f tflint.Fixer) error {
+		return f.ReplaceText(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 12}, End: hcl.Pos{Byte: 15}}, "foobar")
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `
+locals {
+  foobar = "bar"
+  baz    = 1
+}
+
+variable "foo" {
+  type = string
+  default = "bar"
+}`
+	if diff := cmp.Diff(want, string(runner.Changes()["main.tf"])); diff != "" {
+		t.Error(diff)
+	}
+}
+
 func Test_EnsureNoError(t *testing.T) {
 	tests := []struct {
 		Name    string
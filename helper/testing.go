@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
 	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/terraform-linters/tflint-plugin-sdk/terraform"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
 
@@ -58,6 +59,7 @@ func AssertIssues(t *testing.T, want Issues, got Issues) {
 	opts := []cmp.Option{
 		// Byte field will be ignored because it's not important in tests such as positions
 		cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+		cmpopts.IgnoreFields(Issue{}, "Fixes"),
 		// Issues will be sorted and output in the end, so ignore the order.
 		ignoreIssuesOrder(),
 		ruleComparer(),
@@ -67,12 +69,26 @@ func AssertIssues(t *testing.T, want Issues, got Issues) {
 	}
 }
 
+// AssertIssuesWithFixes is an assertion helper for comparing issues including alternative fixes.
+func AssertIssuesWithFixes(t *testing.T, want Issues, got Issues) {
+	t.Helper()
+
+	opts := []cmp.Option{
+		cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+		ignoreIssuesOrder(),
+		ruleComparer(),
+	}
+	if diff := cmp.Diff(want, got, opts...); diff != "" {
+		t.Fatalf("Expected issues are not matched:\n %s\n", diff)
+	}
+}
+
 // AssertIssuesWithoutRange is an assertion helper for comparing issues except for range.
 func AssertIssuesWithoutRange(t *testing.T, want Issues, got Issues) {
 	t.Helper()
 
 	opts := []cmp.Option{
-		cmpopts.IgnoreFields(Issue{}, "Range"),
+		cmpopts.IgnoreFields(Issue{}, "Range", "Fixes"),
 		ignoreIssuesOrder(),
 		ruleComparer(),
 	}
@@ -94,6 +110,79 @@ func AssertChanges(t *testing.T, want map[string]string, got map[string][]byte)
 	}
 }
 
+// AssertFix is an assertion helper for verifying the fixes of the given rule.
+// It runs the rule against the files and compares the changes as AssertChanges does.
+// Then it verifies the fixed files as follows:
+//
+//   - The fixed files can be parsed.
+//   - Running the rule again emits only the issues that could not be fixed.
+//   - Running the rule again produces no changes, i.e. the fixes are idempotent.
+//
+// Conflicting fixes cannot be applied in one pass, so they are reported as failures.
+func AssertFix(t *testing.T, rule tflint.Rule, files map[string]string, want map[string]string) {
+	t.Helper()
+
+	runner := TestRunner(t, files)
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Failed to check the rule: %s", err)
+	}
+	for _, conflict := range runner.Conflicts {
+		t.Errorf("The fix for %q conflicts with another fix: %s", conflict.Issue.Message, conflict.Reason)
+	}
+	changes := runner.Changes()
+	AssertChanges(t, want, changes)
+
+	fixed := map[string]string{}
+	for name, src := range files {
+		fixed[name] = src
+	}
+	parser := hclparse.NewParser()
+	for name, src := range changes {
+		var diags hcl.Diagnostics
+		if terraform.IsJSONFilename(name) {
+			_, diags = parser.ParseJSON(src, name)
+		} else {
+			_, diags = parser.ParseHCL(src, name)
+		}
+		if diags.HasErrors() {
+			t.Fatalf("The fixed file is invalid:\n %s\n\n%s", diags, src)
+		}
+		fixed[name] = string(src)
+	}
+
+	rerun := TestRunner(t, fixed)
+	if err := rule.Check(rerun); err != nil {
+		t.Fatalf("Failed to check the rule against the fixed files: %s", err)
+	}
+
+	unfixed := Issues{}
+	for _, issue := range runner.Issues {
+		if len(issue.Fixes) == 0 {
+			unfixed = append(unfixed, issue)
+		}
+	}
+	opts := []cmp.Option{
+		// Ranges can be changed by fixes, so compare issues by messages.
+		cmpopts.IgnoreFields(Issue{}, "Range", "Fixes"),
+		cmpopts.SortSlices(func(i, j *Issue) bool { return i.Message < j.Message }),
+		ruleComparer(),
+	}
+	if diff := cmp.Diff(unfixed, rerun.Issues, opts...); diff != "" {
+		t.Fatalf("Issues after fixes are not matched with unfixed issues:\n %s\n", diff)
+	}
+
+	refixed := map[string]string{}
+	for name, src := range rerun.Changes() {
+		refixed[name] = string(src)
+	}
+	if len(refixed) > 0 {
+		t.Fatalf("Fixes are not idempotent:\n %s\n", cmp.Diff(fixed, refixed, cmpopts.IgnoreMapEntries(func(name string, _ string) bool {
+			_, changed := refixed[name]
+			return !changed
+		})))
+	}
+}
+
 // ruleComparer returns a Comparer func that checks that two rule interfaces
 // have the same underlying type. It does not compare struct fields.
 func ruleComparer() cmp.Option {
@@ -0,0 +1,94 @@
+package helper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// instanceTypeRule replaces the "t2.micro" instance type with "t3.micro".
+// The "m1.small" instance type is reported but cannot be fixed.
+type instanceTypeRule struct {
+	tflint.DefaultRule
+}
+
+func (r *instanceTypeRule) Name() string              { return "instance_type_rule" }
+func (r *instanceTypeRule) Enabled() bool             { return true }
+func (r *instanceTypeRule) Severity() tflint.Severity { return tflint.ERROR }
+func (r *instanceTypeRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent("aws_instance", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "instance_type"}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attr, exists := resource.Body.Attributes["instance_type"]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attr.Expr, func(instanceType string) error {
+			switch instanceType {
+			case "t2.micro":
+				return runner.EmitIssueWithFix(r, "t2.micro is previous generation", attr.Expr.Range(), func(f tflint.Fixer) error {
+					return f.ReplaceText(attr.Expr.Range(), `"t3.micro"`)
+				})
+			case "m1.small":
+				return runner.EmitIssue(r, fmt.Sprintf("%s is previous generation", instanceType), attr.Expr.Range())
+			default:
+				return nil
+			}
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestTestRunner_json(t *testing.T) {
+	// Any files with the .json suffix are parsed as JSON, not only .tf.json files.
+	runner := TestRunner(t, map[string]string{
+		"main.tf.json": `{"variable": {"foo": {}}}`,
+		"other.json":   `{"variable": {"bar": {}}}`,
+	})
+
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{{Type: "variable", LabelNames: []string{"name"}, Body: &hclext.BodySchema{}}},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(content.Blocks) != 2 {
+		t.Errorf("got %d blocks, but 2 blocks are expected", len(content.Blocks))
+	}
+}
+
+func TestAssertFix(t *testing.T) {
+	files := map[string]string{
+		"main.tf": `
+resource "aws_instance" "foo" {
+  instance_type = "t2.micro"
+}
+
+resource "aws_instance" "bar" {
+  instance_type = "m1.small"
+}`,
+	}
+	want := map[string]string{
+		"main.tf": `
+resource "aws_instance" "foo" {
+  instance_type = "t3.micro"
+}
+
+resource "aws_instance" "bar" {
+  instance_type = "m1.small"
+}`,
+	}
+
+	AssertFix(t, &instanceTypeRule{}, files, want)
+}
@@ -0,0 +1,59 @@
+package internal
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+var errRefTy = reflect.TypeOf((*error)(nil)).Elem()
+
+// PrepareTarget validates the target passed to EvaluateExpr and returns a pointer to assign the value.
+// The returned function must be called with the evaluation error to complete the evaluation.
+//
+// If the target is a callback, a pointer to a new value of the argument type is returned,
+// and the returned function invokes the callback with the assigned value.
+// Otherwise, the target is returned as it is, and the function returns the passed error as it is.
+func PrepareTarget(target interface{}) (interface{}, func(error) error) {
+	rval := reflect.ValueOf(target)
+	rty := rval.Type()
+
+	switch rty.Kind() {
+	case reflect.Func:
+		// Callback must meet the following requirements:
+		//   - It must be a function
+		//   - It must take an argument
+		//   - It must return an error
+		if !(rty.NumIn() == 1 && rty.NumOut() == 1 && rty.Out(0).Implements(errRefTy)) {
+			panic(`callback must be of type "func (v T) error"`)
+		}
+
+	case reflect.Pointer:
+		// error should be handled in the caller
+		return target, func(err error) error { return err }
+	default:
+		panic("target value is not a pointer or function")
+	}
+
+	arg := reflect.New(rty.In(0))
+	return arg.Interface(), func(err error) error {
+		if err != nil {
+			// If it cannot be represented as a Go value, exit without invoking the callback rather than returning an error.
+			if errors.Is(err, tflint.ErrUnknownValue) ||
+				errors.Is(err, tflint.ErrNullValue) ||
+				errors.Is(err, tflint.ErrSensitive) ||
+				errors.Is(err, tflint.ErrEphemeral) ||
+				errors.Is(err, tflint.ErrUnevaluable) {
+				return nil
+			}
+			return err
+		}
+
+		rerr := rval.Call([]reflect.Value{arg.Elem()})
+		if rerr[0].IsNil() {
+			return nil
+		}
+		return rerr[0].Interface().(error)
+	}
+}
@@ -3,6 +3,7 @@ package internal
 import (
 	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
 	"strings"
 	"unicode"
@@ -10,7 +11,6 @@ import (
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
-	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/terraform"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
@@ -20,11 +20,18 @@ import (
 // Fixer is a tool to rewrite HCL source code.
 type Fixer struct {
 	sources map[string][]byte
+	lines   map[string]*lineIndex
 	changes map[string][]byte
 	shifts  []shift
+	edits   []tflint.TextEdit
+	// fixStart is the number of edits made by the fixes of previous issues.
+	fixStart   int
+	formatMode tflint.FormatMode
+	// formatModes is the format mode of each changed file.
+	// See recordFormatMode for details.
+	formatModes map[string]tflint.FormatMode
 
-	stashedChanges map[string][]byte
-	stashedShifts  []shift
+	stash fixerSnapshot
 }
 
 type shift struct {
@@ -37,16 +44,20 @@ type shift struct {
 func NewFixer(sources map[string][]byte) *Fixer {
 	return &Fixer{
 		sources: sources,
+		lines:   map[string]*lineIndex{},
 		changes: map[string][]byte{},
 		shifts:  []shift{},
+		edits:   []tflint.TextEdit{},
 
-		stashedChanges: map[string][]byte{},
-		stashedShifts:  []shift{},
+		formatModes: map[string]tflint.FormatMode{},
+
+		stash: fixerSnapshot{changes: map[string][]byte{}, shifts: []shift{}, edits: []tflint.TextEdit{}, formatModes: map[string]tflint.FormatMode{}},
 	}
 }
 
 // ReplaceText rewrites the given range of source code to a new text.
 // If the range is overlapped with a previous rewrite range, it returns an error.
+// Newlines in the text are converted to the newline sequence (LF or CRLF) used in the file.
 //
 // Either string or tflint.TextNode is valid as an argument.
 // TextNode can be obtained with fixer.TextAt(range).
@@ -87,6 +98,18 @@ func (f *Fixer) ReplaceText(rng hcl.Range, texts ...any) error {
 }
 
 func (f *Fixer) replaceText(rng hcl.Range, new string) error {
+	target := rng
+
+	// Fixes of previous issues take precedence over the current fix.
+	for _, edit := range f.edits[:min(f.fixStart, len(f.edits))] {
+		if editsOverlap(edit.Range, rng) {
+			return &ConflictError{Range: rng, Edit: edit}
+		}
+	}
+
+	// Follow the newline convention of the file, regardless of the text passed by the fix.
+	new = convertNewlines(new, newlineOf(f.sources[rng.Filename]))
+
 	// If there are already changes, overwrite the changed content.
 	var file []byte
 	if change, exists := f.changes[rng.Filename]; exists {
@@ -151,9 +174,77 @@ func (f *Fixer) replaceText(rng hcl.Range, new string) error {
 	}
 
 	f.changes[rng.Filename] = buf.Bytes()
+	f.recordEdit(target, new)
+	f.recordFormatMode(rng.Filename)
 	return nil
 }
 
+// recordFormatMode records the current format mode as the format mode of the changed file.
+// If the file is changed by fixes with different format modes, e.g. fixes of rules
+// that opt out of formatting, the mode that formats more regions takes precedence,
+// since formatting is not limited to the changes of a particular fix.
+func (f *Fixer) recordFormatMode(filename string) {
+	// FormatMode values are ordered from the mode that formats the most.
+	if mode, exists := f.formatModes[filename]; !exists || f.formatMode < mode {
+		f.formatModes[filename] = f.formatMode
+	}
+}
+
+// ConflictError is an error indicating that the rewrite range overlaps with
+// the edit made by the fix of a previous issue.
+type ConflictError struct {
+	// Range is the rewrite range of the current fix.
+	Range hcl.Range
+	// Edit is the conflicting edit of the previous fix.
+	Edit tflint.TextEdit
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s: %s", tflint.ErrFixConflict, e.Edit.Range)
+}
+
+func (e *ConflictError) Unwrap() error {
+	return tflint.ErrFixConflict
+}
+
+// editsOverlap returns true if the given ranges overlap by byte offsets.
+// Unlike hcl.Range.Overlap, an empty range (insertion) inside another range is also treated as overlapping.
+// Insertions at the same position do not overlap.
+func editsOverlap(a, b hcl.Range) bool {
+	if a.Filename != b.Filename {
+		return false
+	}
+	if a.Empty() && b.Empty() {
+		return false
+	}
+	if a.Empty() {
+		return b.Start.Byte < a.Start.Byte && a.Start.Byte < b.End.Byte
+	}
+	if b.Empty() {
+		return a.Start.Byte < b.Start.Byte && b.Start.Byte < a.End.Byte
+	}
+	return a.Start.Byte < b.End.Byte && b.Start.Byte < a.End.Byte
+}
+
+// recordEdit records the rewrite as a text edit on the original source.
+// If the range is the same as a previous edit, the previous edit is updated.
+func (f *Fixer) recordEdit(rng hcl.Range, new string) {
+	edit := tflint.TextEdit{
+		Range:   f.rangeAt(rng.Filename, rng.Start.Byte, rng.End.Byte),
+		NewText: new,
+	}
+
+	if !edit.Range.Empty() {
+		for i, e := range f.edits {
+			if e.Range == edit.Range {
+				f.edits[i] = edit
+				return
+			}
+		}
+	}
+	f.edits = append(f.edits, edit)
+}
+
 // InsertTextBefore inserts the given text before the given range.
 func (f *Fixer) InsertTextBefore(rng hcl.Range, text string) error {
 	return f.ReplaceText(hcl.Range{Filename: rng.Filename, Start: rng.Start, End: rng.Start}, text)
@@ -172,11 +263,10 @@ func (f *Fixer) Remove(rng hcl.Range) error {
 // RemoveAttribute removes the given attribute from the source code.
 // The difference from Remove is that it removes the attribute
 // and the associated newlines, indentations, and comments.
-// This only works for HCL native syntax. JSON syntax is not supported
-// and returns tflint.ErrFixNotSupported.
+// In JSON syntax, the property is removed including the comma.
 func (f *Fixer) RemoveAttribute(attr *hcl.Attribute) error {
 	if terraform.IsJSONFilename(attr.Range.Filename) {
-		return tflint.ErrFixNotSupported
+		return f.removeJSONAttribute(attr)
 	}
 
 	rng, err := f.expandRangeToTrivialTokens(attr.Range)
@@ -189,40 +279,18 @@ func (f *Fixer) RemoveAttribute(attr *hcl.Attribute) error {
 // RemoveBlock removes the given block from the source code.
 // The difference from Remove is that it removes the block
 // and the associated newlines, indentations, and comments.
-// This only works for HCL native syntax. JSON syntax is not supported
-// and returns tflint.ErrFixNotSupported.
+// In JSON syntax, the property or the array element is removed including the comma,
+// and the parent objects for the block type and labels are also removed if they become empty.
 func (f *Fixer) RemoveBlock(block *hcl.Block) error {
 	if terraform.IsJSONFilename(block.DefRange.Filename) {
-		return tflint.ErrFixNotSupported
-	}
-
-	source, exists := f.sources[block.DefRange.Filename]
-	if !exists {
-		return fmt.Errorf("file not found: %s", block.DefRange.Filename)
-	}
-	// Parse the source code to get the whole block range.
-	// Notice that hcl.Block does not have the whole range, but hclsyntax.Block does.
-	file, diags := hclsyntax.ParseConfig(source, block.DefRange.Filename, hcl.InitialPos)
-	if diags.HasErrors() {
-		return diags
+		return f.removeJSONBlock(block)
 	}
 
-	var blockRange hcl.Range
-	diags = hclsyntax.VisitAll(file.Body.(*hclsyntax.Body), func(node hclsyntax.Node) hcl.Diagnostics {
-		if nativeBlock, ok := node.(*hclsyntax.Block); ok {
-			if nativeBlock.TypeRange.Start.Byte == block.TypeRange.Start.Byte {
-				blockRange = hcl.RangeBetween(block.DefRange, nativeBlock.CloseBraceRange)
-				return nil
-			}
-		}
-		return nil
-	})
-	if diags.HasErrors() {
-		return diags
-	}
-	if blockRange.Empty() {
-		return fmt.Errorf("block not found at %s:%d,%d", block.DefRange.Filename, block.DefRange.Start.Line, block.DefRange.Start.Column)
+	_, nativeBlock, err := f.findNativeBlock(block.DefRange.Filename, block.TypeRange.Start)
+	if err != nil {
+		return err
 	}
+	blockRange := hcl.RangeBetween(block.DefRange, nativeBlock.CloseBraceRange)
 
 	rng, err := f.expandRangeToTrivialTokens(blockRange)
 	if err != nil {
@@ -247,6 +315,311 @@ func (f *Fixer) RemoveExtBlock(block *hclext.Block) error {
 	})
 }
 
+// SetAttribute sets the value of the attribute with the given name in the given block.
+// If the attribute exists, its expression is replaced with the given value.
+// Otherwise, a new attribute is inserted after the last attribute in the block.
+// The value is a text of an HCL expression, like the result of ValueText.
+// Continuation lines of a multi-line value are indented to match the line it is set on.
+// In JSON syntax, literal values are converted to JSON values, and other expressions
+// are converted to template strings like "${var.foo}".
+func (f *Fixer) SetAttribute(block *hclext.Block, name string, value string) error {
+	if terraform.IsJSONFilename(block.DefRange.Filename) {
+		return f.setJSONAttribute(block, name, value)
+	}
+
+	source, nativeBlock, err := f.findNativeBlock(block.DefRange.Filename, block.TypeRange.Start)
+	if err != nil {
+		return err
+	}
+
+	if attr, exists := nativeBlock.Body.Attributes[name]; exists {
+		return f.ReplaceText(attr.Expr.Range(), indentExpr(value, lineIndent(source, attr.SrcRange.Start.Byte)))
+	}
+
+	indent := lineIndent(source, nativeBlock.TypeRange.Start.Byte) + "  "
+	if nativeBlock.OpenBraceRange.Start.Line == nativeBlock.CloseBraceRange.Start.Line {
+		return f.expandInlineBody(source, nativeBlock.OpenBraceRange, nativeBlock.CloseBraceRange, indent, fmt.Sprintf("%s = %s", name, indentExpr(value, indent)))
+	}
+
+	// Insert the attribute after the last attribute.
+	// If there are no attributes, insert it at the beginning of the body.
+	var last *hclsyntax.Attribute
+	for _, attr := range nativeBlock.Body.Attributes {
+		if last == nil || last.SrcRange.End.Byte < attr.SrcRange.End.Byte {
+			last = attr
+		}
+	}
+	var pos hcl.Pos
+	if last != nil {
+		pos = nextLineStart(source, last.SrcRange.End)
+		indent = lineIndent(source, last.SrcRange.Start.Byte)
+	} else {
+		pos = nextLineStart(source, nativeBlock.OpenBraceRange.End)
+	}
+	text := fmt.Sprintf("%s = %s", name, indentExpr(value, indent))
+
+	return f.ReplaceText(hcl.Range{Filename: block.DefRange.Filename, Start: pos, End: pos}, indent+text+"\n")
+}
+
+// AppendBlock appends a nested block rendered from the given HCL snippet
+// to the end of the given block. The snippet is indented to match the given block.
+// In JSON syntax, the snippet must contain a single block, and it is converted to
+// a JSON object in the same way as SetAttribute. If there are already blocks of the type,
+// the block is appended to the array of them.
+func (f *Fixer) AppendBlock(block *hclext.Block, snippet string) error {
+	snippet = strings.Trim(snippet, "\n")
+	file, diags := hclsyntax.ParseConfig([]byte(snippet), "snippet.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		return diags
+	}
+
+	if terraform.IsJSONFilename(block.DefRange.Filename) {
+		return f.appendJSONBlock(block, file.Body.(*hclsyntax.Body), file.Bytes)
+	}
+
+	source, nativeBlock, err := f.findNativeBlock(block.DefRange.Filename, block.TypeRange.Start)
+	if err != nil {
+		return err
+	}
+
+	indent := lineIndent(source, nativeBlock.TypeRange.Start.Byte) + "  "
+	lines := strings.Split(snippet, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			lines[i] = indent + line
+		}
+	}
+	text := strings.Join(lines, "\n")
+
+	if nativeBlock.OpenBraceRange.Start.Line == nativeBlock.CloseBraceRange.Start.Line {
+		return f.expandInlineBody(source, nativeBlock.OpenBraceRange, nativeBlock.CloseBraceRange, indent, strings.TrimPrefix(text, indent))
+	}
+
+	// Insert the block before the closing brace, separated from the existing content by a blank line.
+	if len(nativeBlock.Body.Attributes) > 0 || len(nativeBlock.Body.Blocks) > 0 {
+		text = "\n" + text
+	}
+	return f.insertBeforeCloseBrace(source, nativeBlock.CloseBraceRange, text, strings.TrimSuffix(indent, "  "))
+}
+
+// SetObjectItem sets the value of the given key in the given object expression.
+// If the key exists, its value is replaced with the given value.
+// Otherwise, a new item is inserted at the end of the object.
+// The value is a text of an HCL expression, like the result of ValueText.
+// Continuation lines of a multi-line value are indented to match the line it is set on.
+// In JSON syntax, literal values are converted to JSON values, and other expressions
+// are converted to template strings like "${var.foo}".
+func (f *Fixer) SetObjectItem(expr hcl.Expression, key string, value string) error {
+	filename := expr.Range().Filename
+	if terraform.IsJSONFilename(filename) {
+		return f.setJSONObjectItem(expr, key, value)
+	}
+
+	source, exists := f.sources[filename]
+	if !exists {
+		return fmt.Errorf("file not found: %s", filename)
+	}
+	file, diags := hclsyntax.ParseConfig(source, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return diags
+	}
+
+	var object *hclsyntax.ObjectConsExpr
+	diags = hclsyntax.VisitAll(file.Body.(*hclsyntax.Body), func(node hclsyntax.Node) hcl.Diagnostics {
+		if cons, ok := node.(*hclsyntax.ObjectConsExpr); ok && object == nil {
+			if cons.SrcRange.Start.Byte == expr.Range().Start.Byte && cons.SrcRange.End.Byte == expr.Range().End.Byte {
+				object = cons
+			}
+		}
+		return nil
+	})
+	if diags.HasErrors() {
+		return diags
+	}
+	if object == nil {
+		return fmt.Errorf("object expression not found at %s", expr.Range())
+	}
+
+	for _, item := range object.Items {
+		k, diags := item.KeyExpr.Value(nil)
+		if diags.HasErrors() || k.Type() != cty.String || !k.IsKnown() || k.IsNull() {
+			continue
+		}
+		if k.AsString() == key {
+			return f.ReplaceText(item.ValueExpr.Range(), indentExpr(value, lineIndent(source, item.KeyExpr.Range().Start.Byte)))
+		}
+	}
+
+	keyText := key
+	if !hclsyntax.ValidIdentifier(key) {
+		keyText = f.ValueText(cty.StringVal(key))
+	}
+	text := fmt.Sprintf("%s = %s", keyText, indentExpr(value, lineIndent(source, object.OpenRange.Start.Byte)))
+
+	closeBrace := hcl.Range{Filename: filename, Start: object.SrcRange.End, End: object.SrcRange.End}
+	closeBrace.Start.Byte--
+	closeBrace.Start.Column--
+
+	if object.OpenRange.Start.Line == closeBrace.Start.Line {
+		if len(object.Items) == 0 {
+			return f.ReplaceText(hcl.Range{Filename: filename, Start: object.OpenRange.End, End: closeBrace.Start}, fmt.Sprintf(" %s ", text))
+		}
+		// Insert the item after the last item. If the last item is followed by a comma, insert it after the comma.
+		pos := object.Items[len(object.Items)-1].ValueExpr.Range().End
+		rest := source[pos.Byte:closeBrace.Start.Byte]
+		if trimmed := bytes.TrimLeft(rest, " \t"); len(trimmed) > 0 && trimmed[0] == ',' {
+			pos.Byte += len(rest) - len(trimmed) + 1
+			pos.Column += len(rest) - len(trimmed) + 1
+			return f.InsertTextAfter(hcl.Range{Filename: filename, Start: pos, End: pos}, " "+text)
+		}
+		return f.InsertTextAfter(hcl.Range{Filename: filename, Start: pos, End: pos}, ", "+text)
+	}
+
+	closeIndent := lineIndent(source, object.OpenRange.Start.Byte)
+	indent := closeIndent + "  "
+	if len(object.Items) > 0 {
+		indent = lineIndent(source, object.Items[len(object.Items)-1].KeyExpr.Range().Start.Byte)
+	}
+	text = fmt.Sprintf("%s = %s", keyText, indentExpr(value, indent))
+	return f.insertBeforeCloseBrace(source, closeBrace, indent+text, closeIndent)
+}
+
+// findNativeBlock returns the source and hclsyntax.Block which starts at the given position.
+func (f *Fixer) findNativeBlock(filename string, start hcl.Pos) ([]byte, *hclsyntax.Block, error) {
+	source, exists := f.sources[filename]
+	if !exists {
+		return nil, nil, fmt.Errorf("file not found: %s", filename)
+	}
+	// Parse the source code to get the whole block range.
+	// Notice that hcl.Block does not have the whole range, but hclsyntax.Block does.
+	file, diags := hclsyntax.ParseConfig(source, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, nil, diags
+	}
+
+	var block *hclsyntax.Block
+	diags = hclsyntax.VisitAll(file.Body.(*hclsyntax.Body), func(node hclsyntax.Node) hcl.Diagnostics {
+		if nativeBlock, ok := node.(*hclsyntax.Block); ok {
+			if nativeBlock.TypeRange.Start.Byte == start.Byte {
+				block = nativeBlock
+				return nil
+			}
+		}
+		return nil
+	})
+	if diags.HasErrors() {
+		return nil, nil, diags
+	}
+	if block == nil {
+		return nil, nil, fmt.Errorf("block not found at %s:%d,%d", filename, start.Line, start.Column)
+	}
+
+	return source, block, nil
+}
+
+// expandInlineBody rewrites an inline body like "block { foo = 1 }" to a multi-line body
+// and appends the given text to the end of the body.
+func (f *Fixer) expandInlineBody(source []byte, open hcl.Range, close hcl.Range, indent string, text string) error {
+	closeIndent := strings.TrimSuffix(indent, "  ")
+
+	content := "\n"
+	if existing := strings.TrimSpace(string(source[open.End.Byte:close.Start.Byte])); existing != "" {
+		content += indent + existing + "\n"
+	}
+	content += indent + text + "\n" + closeIndent
+
+	return f.ReplaceText(hcl.Range{Filename: open.Filename, Start: open.End, End: close.Start}, content)
+}
+
+// insertBeforeCloseBrace inserts the given text as new lines before the closing brace of a multi-line body.
+// closeIndent is the indentation for the closing brace when it needs to be moved to a new line.
+func (f *Fixer) insertBeforeCloseBrace(source []byte, close hcl.Range, text string, closeIndent string) error {
+	lineStart := close.Start
+	for lineStart.Byte > 0 && source[lineStart.Byte-1] != '\n' {
+		lineStart.Byte--
+		lineStart.Column--
+	}
+
+	// If there is something before the closing brace on the same line, break the line before the brace.
+	//
+	//   foo = 1 }
+	if before := source[lineStart.Byte:close.Start.Byte]; len(bytes.TrimSpace(before)) > 0 {
+		start := close.Start
+		trailing := len(before) - len(bytes.TrimRight(before, " \t"))
+		start.Byte -= trailing
+		start.Column -= trailing
+		return f.ReplaceText(hcl.Range{Filename: close.Filename, Start: start, End: close.Start}, "\n"+text+"\n"+closeIndent)
+	}
+	return f.InsertTextBefore(hcl.Range{Filename: close.Filename, Start: lineStart, End: lineStart}, text+"\n")
+}
+
+// lineIndent returns the leading whitespaces of the line that contains the given byte offset.
+func lineIndent(source []byte, offset int) string {
+	start := offset
+	for start > 0 && source[start-1] != '\n' {
+		start--
+	}
+	end := start
+	for end < len(source) && (source[end] == ' ' || source[end] == '\t') {
+		end++
+	}
+	return string(source[start:end])
+}
+
+// indentExpr indents the continuation lines of the given expression text, so that
+// multi-line values like objects follow the indentation of the line they are inserted into.
+// Lines in heredocs are left as they are, because their indentation is part of the value.
+func indentExpr(text string, indent string) string {
+	if indent == "" || !strings.Contains(text, "\n") {
+		return text
+	}
+	tokens, diags := hclsyntax.LexExpression([]byte(text), "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return text
+	}
+	var heredocs []hcl.Range
+	var heredocStart hcl.Pos
+	for _, token := range tokens {
+		switch token.Type {
+		case hclsyntax.TokenOHeredoc:
+			heredocStart = token.Range.End
+		case hclsyntax.TokenCHeredoc:
+			heredocs = append(heredocs, hcl.Range{Start: heredocStart, End: token.Range.End})
+		}
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		b.WriteByte(text[i])
+		if text[i] != '\n' || i+1 == len(text) || text[i+1] == '\n' {
+			continue
+		}
+		inHeredoc := false
+		for _, heredoc := range heredocs {
+			if heredoc.Start.Byte <= i+1 && i+1 < heredoc.End.Byte {
+				inHeredoc = true
+				break
+			}
+		}
+		if !inHeredoc {
+			b.WriteString(indent)
+		}
+	}
+	return b.String()
+}
+
+// nextLineStart returns the start position of the next line of the given position.
+func nextLineStart(source []byte, pos hcl.Pos) hcl.Pos {
+	for pos.Byte < len(source) {
+		pos.Byte++
+		pos.Column++
+		if source[pos.Byte-1] == '\n' {
+			return hcl.Pos{Line: pos.Line + 1, Column: 1, Byte: pos.Byte}
+		}
+	}
+	return pos
+}
+
 // expandRangeToTrivialTokens expands the given range to include comments, newlines, and indentations.
 func (f *Fixer) expandRangeToTrivialTokens(rng hcl.Range) (hcl.Range, error) {
 	source, exists := f.sources[rng.Filename]
@@ -555,21 +928,59 @@ func (f *Fixer) Changes() map[string][]byte {
 	return f.changes
 }
 
+// Edits returns the changes made by the fixer as text edits on the original sources.
+// Unlike Changes, the edits are not affected by FormatChanges.
+// Note this API is not intended to be used by plugins.
+func (f *Fixer) Edits() []tflint.TextEdit {
+	return f.edits
+}
+
 // HasChanges returns true if the fixer has changes.
 // Note this API is not intended to be used by plugins.
 func (f *Fixer) HasChanges() bool {
 	return len(f.changes) > 0
 }
 
-// FormatChanges formats the changes made by the fixer.
+// FormatChanges formats the changes made by the fixer according to the format mode.
 // Note this API is not intended to be used by plugins.
 func (f *Fixer) FormatChanges() {
 	for filename, content := range f.changes {
-		if terraform.IsJSONFilename(filename) {
-			continue
+		f.changes[filename] = f.format(filename, content)
+	}
+}
+
+// SetFormatMode sets how FormatChanges and RunFixes format the files changed by subsequent fixes.
+// Files that have already been changed keep the format mode at that time.
+// Note this API is not intended to be used by plugins.
+func (f *Fixer) SetFormatMode(mode tflint.FormatMode) {
+	f.formatMode = mode
+}
+
+func (f *Fixer) format(filename string, content []byte) []byte {
+	mode, exists := f.formatModes[filename]
+	if !exists {
+		mode = f.formatMode
+	}
+
+	if mode == tflint.FormatModeNone {
+		return content
+	}
+	if terraform.IsJSONFilename(filename) {
+		// Re-indenting JSON would change every line that is not indented in the same way,
+		// so leave it as it is. Fixes to JSON files follow the indentation of the surrounding members.
+		if mode == tflint.FormatModeChangedBlocks {
+			return content
+		}
+		// If the content is broken, leave it as it is.
+		if formatted, err := formatJSON(f.sources[filename], content); err == nil {
+			return formatted
 		}
-		f.changes[filename] = hclwrite.Format(content)
+		return content
 	}
+	if mode == tflint.FormatModeChangedBlocks {
+		return f.formatChangedBlocks(filename, content)
+	}
+	return formatHCL(content)
 }
 
 // ApplyChanges applies the changes made by the fixer.
@@ -577,29 +988,160 @@ func (f *Fixer) FormatChanges() {
 func (f *Fixer) ApplyChanges() {
 	for filename, content := range f.changes {
 		f.sources[filename] = content
+		delete(f.lines, filename)
 	}
 	f.changes = map[string][]byte{}
 	f.shifts = []shift{}
+	f.edits = []tflint.TextEdit{}
+	f.formatModes = map[string]tflint.FormatMode{}
+	f.fixStart = 0
 }
 
 // StashChanges stashes the current changes.
 // Note this API is not intended to be used by plugins.
 func (f *Fixer) StashChanges() {
-	f.stashedChanges = map[string][]byte{}
-	for k, v := range f.changes {
-		f.stashedChanges[k] = v
-	}
-	f.stashedShifts = make([]shift, len(f.shifts))
-	copy(f.stashedShifts, f.shifts)
+	f.stash = f.snapshot()
 }
 
 // PopChangesFromStash pops changes from the stash.
 // Note this API is not intended to be used by plugins.
 func (f *Fixer) PopChangesFromStash() {
+	f.restore(f.stash)
+}
+
+// FixResult is the result of an alternative fix invoked by RunFixes.
+type FixResult struct {
+	Name string
+	// Changes are the formatted contents of the files changed by the fix.
+	Changes map[string][]byte
+	// Edits are the text edits made by the fix.
+	Edits []tflint.TextEdit
+	// Err is an error returned from the fix, except for ErrFixNotSupported and conflicts.
+	Err error
+	// Conflict is set if the fix conflicts with the fix of a previous issue.
+	// In this case, Changes and Edits are empty.
+	Conflict *ConflictError
+}
+
+// DefaultFix returns the first fix that does not conflict with previous fixes.
+// It returns nil if there is no such fix.
+func DefaultFix(results []FixResult) *FixResult {
+	for i := range results {
+		if results[i].Conflict == nil {
+			return &results[i]
+		}
+	}
+	return nil
+}
+
+// OfferedFixes returns the results that can be offered as alternative fixes.
+// Fixes that return an error, except for the default fix, are excluded because they
+// cannot be applied. The error of the default fix is left to the caller.
+func OfferedFixes(results []FixResult) []FixResult {
+	defaultFix := DefaultFix(results)
+
+	ret := []FixResult{}
+	for i := range results {
+		if results[i].Err != nil && &results[i] != defaultFix {
+			continue
+		}
+		ret = append(ret, results[i])
+	}
+	return ret
+}
+
+// RunFixes invokes the passed fixes independently and returns the results.
+// Fixes that return ErrFixNotSupported are excluded from the results.
+// Fixes that conflict with the fixes of previous issues are included with the conflict.
+// The changes of the default fix (see DefaultFix) remain in the fixer,
+// and the changes of other fixes are discarded.
+// Note this API is not intended to be used by plugins.
+func (f *Fixer) RunFixes(fixes []tflint.Fix) []FixResult {
+	base := f.snapshot()
+	f.fixStart = len(base.edits)
+	var defaultFix *fixerSnapshot
+
+	results := []FixResult{}
+	for _, fix := range fixes {
+		f.restore(base)
+
+		err := fix.Func(f)
+		if errors.Is(err, tflint.ErrFixNotSupported) {
+			continue
+		}
+		var conflict *ConflictError
+		if errors.As(err, &conflict) {
+			results = append(results, FixResult{Name: fix.Name, Changes: map[string][]byte{}, Edits: []tflint.TextEdit{}, Conflict: conflict})
+			continue
+		}
+
+		changes := map[string][]byte{}
+		for filename, content := range f.changes {
+			if bytes.Equal(content, base.changes[filename]) {
+				continue
+			}
+			changes[filename] = f.format(filename, content)
+		}
+		edits := []tflint.TextEdit{}
+		for i, edit := range f.edits {
+			if i < len(base.edits) && base.edits[i] == edit {
+				continue
+			}
+			edits = append(edits, edit)
+		}
+
+		results = append(results, FixResult{Name: fix.Name, Changes: changes, Edits: edits, Err: err})
+
+		if defaultFix == nil {
+			snapshot := f.snapshot()
+			defaultFix = &snapshot
+		}
+	}
+
+	if defaultFix == nil {
+		defaultFix = &base
+	}
+	f.restore(*defaultFix)
+	return results
+}
+
+type fixerSnapshot struct {
+	changes     map[string][]byte
+	shifts      []shift
+	edits       []tflint.TextEdit
+	formatModes map[string]tflint.FormatMode
+}
+
+func (f *Fixer) snapshot() fixerSnapshot {
+	ret := fixerSnapshot{
+		changes: map[string][]byte{},
+		shifts:  make([]shift, len(f.shifts)),
+		edits:   make([]tflint.TextEdit, len(f.edits)),
+
+		formatModes: map[string]tflint.FormatMode{},
+	}
+	for k, v := range f.changes {
+		ret.changes[k] = v
+	}
+	for k, v := range f.formatModes {
+		ret.formatModes[k] = v
+	}
+	copy(ret.shifts, f.shifts)
+	copy(ret.edits, f.edits)
+	return ret
+}
+
+func (f *Fixer) restore(snapshot fixerSnapshot) {
 	f.changes = map[string][]byte{}
-	for k, v := range f.stashedChanges {
+	for k, v := range snapshot.changes {
 		f.changes[k] = v
 	}
-	f.shifts = make([]shift, len(f.stashedShifts))
-	copy(f.shifts, f.stashedShifts)
+	f.shifts = make([]shift, len(snapshot.shifts))
+	copy(f.shifts, snapshot.shifts)
+	f.edits = make([]tflint.TextEdit, len(snapshot.edits))
+	copy(f.edits, snapshot.edits)
+	f.formatModes = map[string]tflint.FormatMode{}
+	for k, v := range snapshot.formatModes {
+		f.formatModes[k] = v
+	}
 }
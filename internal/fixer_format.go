@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// formatChangedBlocks formats only the regions of the content touched by the edits.
+// Each region is expanded to the top-level blocks and attributes it touches in the original source,
+// and then to whole lines. If the original source cannot be parsed, the content is left as it is.
+func (f *Fixer) formatChangedBlocks(filename string, content []byte) []byte {
+	file, diags := hclsyntax.ParseConfig(f.sources[filename], filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return content
+	}
+	body := file.Body.(*hclsyntax.Body)
+
+	items := []hcl.Range{}
+	for _, attr := range body.Attributes {
+		items = append(items, attr.Range())
+	}
+	for _, block := range body.Blocks {
+		items = append(items, block.Range())
+	}
+
+	edits := []tflint.TextEdit{}
+	for _, edit := range f.edits {
+		if edit.Range.Filename == filename {
+			edits = append(edits, edit)
+		}
+	}
+
+	regions := [][2]int{}
+	for _, edit := range edits {
+		start, end := edit.Range.Start.Byte, edit.Range.End.Byte
+		// Removals of whole blocks and attributes leave nothing to format.
+		removal := edit.NewText == ""
+
+		for _, item := range items {
+			if !touches(item, edit.Range) {
+				continue
+			}
+			if start > item.Start.Byte || item.End.Byte > end {
+				removal = false
+			}
+			start = min(start, item.Start.Byte)
+			end = max(end, item.End.Byte)
+		}
+		if removal {
+			continue
+		}
+
+		regions = append(regions, [2]int{
+			lineStart(content, changedOffset(edits, start, true)),
+			lineEnd(content, changedOffset(edits, end, false)),
+		})
+	}
+	if len(regions) == 0 {
+		return content
+	}
+
+	// Merge overlapping regions
+	sort.Slice(regions, func(i, j int) bool { return regions[i][0] < regions[j][0] })
+	merged := [][2]int{regions[0]}
+	for _, region := range regions[1:] {
+		last := &merged[len(merged)-1]
+		if region[0] <= last[1] {
+			last[1] = max(last[1], region[1])
+			continue
+		}
+		merged = append(merged, region)
+	}
+
+	var buf bytes.Buffer
+	prev := 0
+	for _, region := range merged {
+		buf.Write(content[prev:region[0]])
+		buf.Write(formatHCL(content[region[0]:region[1]]))
+		prev = region[1]
+	}
+	buf.Write(content[prev:])
+	return buf.Bytes()
+}
+
+// touches returns true if the edit range touches the item.
+// Insertions at the boundaries of the item do not touch it.
+func touches(item hcl.Range, rng hcl.Range) bool {
+	if rng.Empty() {
+		return item.Start.Byte < rng.Start.Byte && rng.Start.Byte < item.End.Byte
+	}
+	return item.Start.Byte < rng.End.Byte && rng.Start.Byte < item.End.Byte
+}
+
+// changedOffset maps the byte offset in the original source to the offset in the changed content.
+// If before is true, the returned offset is placed before the texts inserted at the offset.
+// Otherwise, it is placed after them.
+func changedOffset(edits []tflint.TextEdit, offset int, before bool) int {
+	ret := offset
+	for _, edit := range edits {
+		start, end := edit.Range.Start.Byte, edit.Range.End.Byte
+		if end < offset || (end == offset && (start < offset || !before)) {
+			ret += len(edit.NewText) - (end - start)
+		}
+	}
+	return ret
+}
+
+// lineStart returns the offset of the start of the line containing the given offset.
+func lineStart(content []byte, offset int) int {
+	return bytes.LastIndexByte(content[:offset], '\n') + 1
+}
+
+// lineEnd returns the offset of the end of the line containing the given offset, excluding the newline.
+func lineEnd(content []byte, offset int) int {
+	if idx := bytes.IndexByte(content[offset:], '\n'); idx >= 0 {
+		return offset + idx
+	}
+	return len(content)
+}
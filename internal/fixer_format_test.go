@@ -0,0 +1,189 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func TestFormatChanges_modes(t *testing.T) {
+	src := `resource "aws_instance" "foo" {
+  ami = "ami-12345678"
+  instance_type = "t2.micro"
+}
+
+resource "aws_instance" "bar" {
+  ami = "ami-12345678"
+  instance_type = "t2.micro"
+}
+`
+
+	tests := []struct {
+		name string
+		mode tflint.FormatMode
+		fix  func(*Fixer) error
+		want string
+	}{
+		{
+			name: "file",
+			mode: tflint.FormatModeFile,
+			fix: func(fixer *Fixer) error {
+				return fixer.ReplaceText(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 160}, End: hcl.Pos{Byte: 170}}, `"t3.micro"`)
+			},
+			want: `resource "aws_instance" "foo" {
+  ami           = "ami-12345678"
+  instance_type = "t2.micro"
+}
+
+resource "aws_instance" "bar" {
+  ami           = "ami-12345678"
+  instance_type = "t3.micro"
+}
+`,
+		},
+		{
+			name: "changed blocks",
+			mode: tflint.FormatModeChangedBlocks,
+			fix: func(fixer *Fixer) error {
+				return fixer.ReplaceText(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 160}, End: hcl.Pos{Byte: 170}}, `"t3.micro"`)
+			},
+			want: `resource "aws_instance" "foo" {
+  ami = "ami-12345678"
+  instance_type = "t2.micro"
+}
+
+resource "aws_instance" "bar" {
+  ami           = "ami-12345678"
+  instance_type = "t3.micro"
+}
+`,
+		},
+		{
+			name: "changed blocks with insertion after block",
+			mode: tflint.FormatModeChangedBlocks,
+			fix: func(fixer *Fixer) error {
+				return fixer.InsertTextAfter(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 0}, End: hcl.Pos{Byte: 85}}, "\n\nmoved {\nfrom = aws_instance.old\nto = aws_instance.foo\n}")
+			},
+			want: `resource "aws_instance" "foo" {
+  ami = "ami-12345678"
+  instance_type = "t2.micro"
+}
+
+moved {
+  from = aws_instance.old
+  to   = aws_instance.foo
+}
+
+resource "aws_instance" "bar" {
+  ami = "ami-12345678"
+  instance_type = "t2.micro"
+}
+`,
+		},
+		{
+			name: "changed blocks with insertion between blocks",
+			mode: tflint.FormatModeChangedBlocks,
+			fix: func(fixer *Fixer) error {
+				return fixer.InsertTextBefore(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 87}, End: hcl.Pos{Byte: 87}}, "locals {\nfoo = 1\n}\n")
+			},
+			want: `resource "aws_instance" "foo" {
+  ami = "ami-12345678"
+  instance_type = "t2.micro"
+}
+
+locals {
+  foo = 1
+}
+resource "aws_instance" "bar" {
+  ami = "ami-12345678"
+  instance_type = "t2.micro"
+}
+`,
+		},
+		{
+			name: "changed blocks with removal",
+			mode: tflint.FormatModeChangedBlocks,
+			fix: func(fixer *Fixer) error {
+				return fixer.Remove(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 85}, End: hcl.Pos{Byte: 172}})
+			},
+			want: `resource "aws_instance" "foo" {
+  ami = "ami-12345678"
+  instance_type = "t2.micro"
+}
+`,
+		},
+		{
+			name: "none",
+			mode: tflint.FormatModeNone,
+			fix: func(fixer *Fixer) error {
+				return fixer.ReplaceText(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 160}, End: hcl.Pos{Byte: 170}}, `"t3.micro"`)
+			},
+			want: `resource "aws_instance" "foo" {
+  ami = "ami-12345678"
+  instance_type = "t2.micro"
+}
+
+resource "aws_instance" "bar" {
+  ami = "ami-12345678"
+  instance_type = "t3.micro"
+}
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fixer := NewFixer(map[string][]byte{"main.tf": []byte(src)})
+			fixer.SetFormatMode(test.mode)
+
+			if err := test.fix(fixer); err != nil {
+				t.Fatal(err)
+			}
+			fixer.FormatChanges()
+
+			if diff := cmp.Diff(test.want, string(fixer.Changes()["main.tf"])); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestFormatChanges_changedBlocksJSON(t *testing.T) {
+	src := `{
+  "resource": {
+    "aws_instance": {
+      "foo": {
+          "ami": "ami-12345678",
+        "instance_type": "t2.micro"
+      }
+    }
+  },
+    "locals": {"foo": 1}
+}
+`
+	fixer := NewFixer(map[string][]byte{"main.tf.json": []byte(src)})
+	fixer.SetFormatMode(tflint.FormatModeChangedBlocks)
+
+	if err := fixer.ReplaceText(hcl.Range{Filename: "main.tf.json", Start: hcl.Pos{Byte: 113}, End: hcl.Pos{Byte: 123}}, `"t3.micro"`); err != nil {
+		t.Fatal(err)
+	}
+	fixer.FormatChanges()
+
+	want := `{
+  "resource": {
+    "aws_instance": {
+      "foo": {
+          "ami": "ami-12345678",
+        "instance_type": "t3.micro"
+      }
+    }
+  },
+    "locals": {"foo": 1}
+}
+`
+	if diff := cmp.Diff(want, string(fixer.Changes()["main.tf.json"])); diff != "" {
+		t.Error(diff)
+	}
+}
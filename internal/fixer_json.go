@@ -0,0 +1,592 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/apparentlymart/go-textseg/v15/textseg"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// removeJSONAttribute removes the property of the given attribute including the comma.
+func (f *Fixer) removeJSONAttribute(attr *hcl.Attribute) error {
+	source, root, err := f.parseJSONSource(attr.NameRange.Filename)
+	if err != nil {
+		return err
+	}
+
+	container, idx := root.find(func(m *jsonMember) bool { return m.start == attr.NameRange.Start.Byte })
+	if container == nil {
+		return fmt.Errorf("attribute not found at %s", attr.NameRange)
+	}
+	return f.removeJSONMember(attr.NameRange.Filename, source, container, idx)
+}
+
+// removeJSONBlock removes the property or the array element of the given block.
+// If the parent objects for the block type and labels become empty, they are also removed.
+//
+//	{"resource": {"aws_instance": {"main": {}}}}  =>  {}
+func (f *Fixer) removeJSONBlock(block *hcl.Block) error {
+	source, root, err := f.parseJSONSource(block.DefRange.Filename)
+	if err != nil {
+		return err
+	}
+
+	// In JSON syntax, DefRange is the range of the opening brace of the block body.
+	// If blocks are declared as an array, it is the range of the opening bracket of the array.
+	container, idx := root.find(func(m *jsonMember) bool { return m.value.start == block.DefRange.Start.Byte })
+	if container == nil {
+		return fmt.Errorf("block not found at %s", block.DefRange)
+	}
+	// It is impossible to determine which element is the block in the array.
+	if value := container.members[idx].value; value.array && len(value.members) > 1 {
+		return tflint.ErrFixNotSupported
+	}
+
+	for len(container.members) == 1 && container.parent != nil {
+		parent, parentIdx := root.find(func(m *jsonMember) bool { return m.value == container })
+		if parent == nil || parent.members[parentIdx].start < block.TypeRange.Start.Byte {
+			break
+		}
+		container, idx = parent, parentIdx
+	}
+
+	return f.removeJSONMember(block.DefRange.Filename, source, container, idx)
+}
+
+// setJSONAttribute sets the property of the given block body.
+func (f *Fixer) setJSONAttribute(block *hclext.Block, name string, value string) error {
+	source, body, err := f.findJSONBody(block)
+	if err != nil {
+		return err
+	}
+	return f.setJSONMember(block.DefRange.Filename, source, body, name, value)
+}
+
+// appendJSONBlock appends the block in the given HCL snippet to the given block body.
+// The block is added to the property of the block type. If the property already has blocks,
+// the new block is appended to the array, or the object is converted to an array.
+//
+//	{}                       =>  {"lifecycle": {"create_before_destroy": true}}
+//	{"lifecycle": {...}}     =>  {"lifecycle": [{...}, {"create_before_destroy": true}]}
+//	{"provisioner": [{...}]} =>  {"provisioner": [{...}, {"local-exec": {"command": "echo"}}]}
+func (f *Fixer) appendJSONBlock(block *hclext.Block, snippet *hclsyntax.Body, snippetSource []byte) error {
+	if len(snippet.Attributes) > 0 || len(snippet.Blocks) != 1 {
+		return fmt.Errorf("snippet must contain a single block in JSON syntax")
+	}
+	nested := snippet.Blocks[0]
+
+	filename := block.DefRange.Filename
+	source, object, err := f.findJSONBody(block)
+	if err != nil {
+		return err
+	}
+	bodyText, err := jsonBodyText(nested.Body, snippetSource)
+	if err != nil {
+		return err
+	}
+
+	key, labels := nested.Type, nested.Labels
+	for {
+		member := object.member(key)
+		if member == nil {
+			return f.insertJSONMember(filename, source, object, key, jsonBlockText(labels, bodyText))
+		}
+
+		value := member.value
+		switch {
+		case value.array:
+			text := jsonBlockText(labels, bodyText)
+			if len(value.members) == 0 {
+				return f.ReplaceText(f.rangeAt(filename, value.start+1, value.end-1), text)
+			}
+			last := value.members[len(value.members)-1]
+			return f.InsertTextAfter(f.rangeAt(filename, last.value.end, last.value.end), ", "+text)
+		case value.object && len(labels) > 0:
+			object, key, labels = value, labels[0], labels[1:]
+		case value.object:
+			existing := string(source[value.start:value.end])
+			return f.ReplaceText(f.rangeAt(filename, value.start, value.end), fmt.Sprintf("[%s, %s]", existing, bodyText))
+		default:
+			return fmt.Errorf("%s is not a block at %s", key, f.rangeAt(filename, value.start, value.end))
+		}
+	}
+}
+
+// findJSONBody returns the source and the object of the given block body.
+func (f *Fixer) findJSONBody(block *hclext.Block) ([]byte, *jsonNode, error) {
+	source, root, err := f.parseJSONSource(block.DefRange.Filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := root.findValue(block.DefRange.Start.Byte)
+	if body != nil && body.array {
+		// It is impossible to determine which element is the block in the array.
+		if len(body.members) > 1 {
+			return nil, nil, tflint.ErrFixNotSupported
+		}
+		body = body.members[0].value
+	}
+	if body == nil || !body.object {
+		return nil, nil, fmt.Errorf("block not found at %s", block.DefRange)
+	}
+	return source, body, nil
+}
+
+// setJSONObjectItem sets the property of the given object expression.
+func (f *Fixer) setJSONObjectItem(expr hcl.Expression, key string, value string) error {
+	filename := expr.Range().Filename
+	source, root, err := f.parseJSONSource(filename)
+	if err != nil {
+		return err
+	}
+
+	object := root.findValue(expr.Range().Start.Byte)
+	if object == nil || !object.object {
+		return fmt.Errorf("object expression not found at %s", expr.Range())
+	}
+	return f.setJSONMember(filename, source, object, key, value)
+}
+
+func (f *Fixer) parseJSONSource(filename string) ([]byte, *jsonNode, error) {
+	source, exists := f.sources[filename]
+	if !exists {
+		return nil, nil, fmt.Errorf("file not found: %s", filename)
+	}
+	root, err := parseJSON(source)
+	if err != nil {
+		return nil, nil, err
+	}
+	return source, root, nil
+}
+
+// removeJSONMember removes the member at the given index including the comma.
+//
+//	{"foo": 1, "bar": 2}  =>  {"bar": 2}  (remove until the next member)
+//	{"foo": 1, "bar": 2}  =>  {"foo": 1}  (remove from the end of the previous member)
+//	{"foo": 1}            =>  {}
+func (f *Fixer) removeJSONMember(filename string, source []byte, container *jsonNode, idx int) error {
+	members := container.members
+
+	var start, end int
+	switch {
+	case idx+1 < len(members):
+		start, end = members[idx].start, members[idx+1].start
+	case idx > 0:
+		start, end = members[idx-1].value.end, members[idx].value.end
+	default:
+		start, end = container.start+1, container.end-1
+	}
+
+	return f.Remove(f.rangeAt(filename, start, end))
+}
+
+// setJSONMember replaces the value of the property with the given key,
+// or appends a new property to the end of the object.
+func (f *Fixer) setJSONMember(filename string, source []byte, object *jsonNode, key string, value string) error {
+	jsonValue, err := jsonValueText(value)
+	if err != nil {
+		return err
+	}
+
+	if member := object.member(key); member != nil {
+		return f.ReplaceText(f.rangeAt(filename, member.value.start, member.value.end), jsonValue)
+	}
+	return f.insertJSONMember(filename, source, object, key, jsonValue)
+}
+
+// insertJSONMember appends a new property with the given JSON value to the end of the object.
+// The property is placed on a new line if the members of the object are on separate lines.
+func (f *Fixer) insertJSONMember(filename string, source []byte, object *jsonNode, key string, jsonValue string) error {
+	text := fmt.Sprintf("%s: %s", jsonString(key), jsonValue)
+
+	if len(object.members) == 0 {
+		return f.ReplaceText(f.rangeAt(filename, object.start+1, object.end-1), text)
+	}
+
+	last := object.members[len(object.members)-1]
+	pos := f.rangeAt(filename, last.value.end, last.value.end)
+	if f.posAt(filename, last.start).Line == f.posAt(filename, object.start).Line {
+		return f.InsertTextAfter(pos, ", "+text)
+	}
+	return f.InsertTextAfter(pos, ",\n"+lineIndent(source, last.start)+text)
+}
+
+// jsonValueText converts the given text of an HCL expression to the JSON representation.
+// Literal values are converted to JSON values, and other expressions are converted to
+// template strings like "${var.foo}".
+func jsonValueText(value string) (string, error) {
+	expr, diags := hclsyntax.ParseExpression([]byte(value), "value.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		return "", diags
+	}
+
+	if len(expr.Variables()) == 0 {
+		if val, diags := expr.Value(nil); !diags.HasErrors() && val.IsWhollyKnown() {
+			// Strings in JSON syntax are interpreted as templates, so template sequences must be escaped.
+			val, err := cty.Transform(val, func(path cty.Path, v cty.Value) (cty.Value, error) {
+				if v.Type() != cty.String || v.IsNull() {
+					return v, nil
+				}
+				s := strings.ReplaceAll(v.AsString(), "${", "$${")
+				return cty.StringVal(strings.ReplaceAll(s, "%{", "%%{")), nil
+			})
+			if err != nil {
+				return "", err
+			}
+			out, err := ctyjson.Marshal(val, val.Type())
+			if err != nil {
+				return "", err
+			}
+			return string(out), nil
+		}
+	}
+
+	// If the expression is a template, use it as it is.
+	switch expr.(type) {
+	case *hclsyntax.TemplateExpr, *hclsyntax.TemplateWrapExpr:
+		return value, nil
+	}
+	return jsonString(fmt.Sprintf("${%s}", value)), nil
+}
+
+// jsonBodyText converts the given body in HCL native syntax to a JSON object.
+// Attributes are converted in the same way as jsonValueText, and blocks of the same type
+// are grouped into an array.
+func jsonBodyText(body *hclsyntax.Body, source []byte) (string, error) {
+	attrs := make([]*hclsyntax.Attribute, 0, len(body.Attributes))
+	for _, attr := range body.Attributes {
+		attrs = append(attrs, attr)
+	}
+	sort.Slice(attrs, func(i, j int) bool { return attrs[i].SrcRange.Start.Byte < attrs[j].SrcRange.Start.Byte })
+
+	members := []string{}
+	for _, attr := range attrs {
+		rng := attr.Expr.Range()
+		value, err := jsonValueText(string(source[rng.Start.Byte:rng.End.Byte]))
+		if err != nil {
+			return "", err
+		}
+		members = append(members, fmt.Sprintf("%s: %s", jsonString(attr.Name), value))
+	}
+
+	types := []string{}
+	blocks := map[string][]string{}
+	for _, block := range body.Blocks {
+		text, err := jsonBodyText(block.Body, source)
+		if err != nil {
+			return "", err
+		}
+		if _, exists := blocks[block.Type]; !exists {
+			types = append(types, block.Type)
+		}
+		blocks[block.Type] = append(blocks[block.Type], jsonBlockText(block.Labels, text))
+	}
+	for _, blockType := range types {
+		value := blocks[blockType][0]
+		if len(blocks[blockType]) > 1 {
+			value = fmt.Sprintf("[%s]", strings.Join(blocks[blockType], ", "))
+		}
+		members = append(members, fmt.Sprintf("%s: %s", jsonString(blockType), value))
+	}
+
+	return fmt.Sprintf("{%s}", strings.Join(members, ", ")), nil
+}
+
+// jsonBlockText wraps the given JSON body with objects for the labels.
+func jsonBlockText(labels []string, body string) string {
+	for i := len(labels) - 1; i >= 0; i-- {
+		body = fmt.Sprintf("{%s: %s}", jsonString(labels[i]), body)
+	}
+	return body
+}
+
+// jsonString returns a JSON string literal without escaping HTML characters like "<" and ">".
+func jsonString(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		// never happen
+		panic(err)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// jsonNode is a JSON value with byte offsets in the source.
+// Unlike the HCL JSON parser, it keeps positions of all members,
+// so that the fixer can rewrite JSON configurations without breaking the syntax.
+type jsonNode struct {
+	start   int // start byte offset of the value
+	end     int // end byte offset of the value (exclusive)
+	object  bool
+	array   bool
+	members []*jsonMember
+
+	parent *jsonNode
+}
+
+// jsonMember is a property of an object or an element of an array.
+type jsonMember struct {
+	key   string // property name. It is empty for array elements
+	start int    // start byte offset of the key, or the value for array elements
+	value *jsonNode
+}
+
+// parseJSON parses the given JSON source.
+func parseJSON(src []byte) (*jsonNode, error) {
+	p := &jsonParser{src: src}
+	node, err := p.parseValue(nil)
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if p.pos < len(p.src) {
+		return nil, fmt.Errorf("unexpected character %q at %d", p.src[p.pos], p.pos)
+	}
+	return node, nil
+}
+
+type jsonParser struct {
+	src []byte
+	pos int
+}
+
+func (p *jsonParser) skipSpaces() {
+	for p.pos < len(p.src) {
+		switch p.src[p.pos] {
+		case ' ', '\t', '\r', '\n':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (p *jsonParser) parseValue(parent *jsonNode) (*jsonNode, error) {
+	p.skipSpaces()
+	if p.pos >= len(p.src) {
+		return nil, fmt.Errorf("unexpected end of JSON")
+	}
+
+	node := &jsonNode{start: p.pos, parent: parent}
+	switch p.src[p.pos] {
+	case '{':
+		node.object = true
+		if err := p.parseMembers(node, '}'); err != nil {
+			return nil, err
+		}
+	case '[':
+		node.array = true
+		if err := p.parseMembers(node, ']'); err != nil {
+			return nil, err
+		}
+	case '"':
+		if _, err := p.parseString(); err != nil {
+			return nil, err
+		}
+	default:
+		// Numbers, true, false, and null. The syntax is validated by the HCL parser in advance.
+		for p.pos < len(p.src) && bytes.IndexByte([]byte(" \t\r\n,]}"), p.src[p.pos]) < 0 {
+			p.pos++
+		}
+		if p.pos == node.start {
+			return nil, fmt.Errorf("unexpected character %q at %d", p.src[p.pos], p.pos)
+		}
+	}
+	node.end = p.pos
+	return node, nil
+}
+
+func (p *jsonParser) parseMembers(node *jsonNode, closing byte) error {
+	p.pos++ // skip the opening bracket
+
+	for {
+		p.skipSpaces()
+		if p.pos >= len(p.src) {
+			return fmt.Errorf("unexpected end of JSON")
+		}
+		if p.src[p.pos] == closing {
+			p.pos++
+			return nil
+		}
+		if len(node.members) > 0 {
+			if p.src[p.pos] != ',' {
+				return fmt.Errorf("expected ',' at %d, but got %q", p.pos, p.src[p.pos])
+			}
+			p.pos++
+			p.skipSpaces()
+		}
+
+		member := &jsonMember{start: p.pos}
+		if node.object {
+			key, err := p.parseString()
+			if err != nil {
+				return err
+			}
+			member.key = key
+
+			p.skipSpaces()
+			if p.pos >= len(p.src) || p.src[p.pos] != ':' {
+				return fmt.Errorf("expected ':' at %d", p.pos)
+			}
+			p.pos++
+		}
+
+		value, err := p.parseValue(node)
+		if err != nil {
+			return err
+		}
+		member.value = value
+		node.members = append(node.members, member)
+	}
+}
+
+func (p *jsonParser) parseString() (string, error) {
+	if p.pos >= len(p.src) || p.src[p.pos] != '"' {
+		return "", fmt.Errorf("expected '\"' at %d", p.pos)
+	}
+	start := p.pos
+	p.pos++
+	for p.pos < len(p.src) {
+		switch p.src[p.pos] {
+		case '\\':
+			p.pos += 2
+			continue
+		case '"':
+			p.pos++
+			var ret string
+			if err := json.Unmarshal(p.src[start:p.pos], &ret); err != nil {
+				return "", err
+			}
+			return ret, nil
+		}
+		p.pos++
+	}
+	return "", fmt.Errorf("unexpected end of JSON")
+}
+
+// find returns the node that satisfies the given condition in depth-first order.
+// The container and the index of the member are also returned if the node is a member.
+func (n *jsonNode) find(cond func(*jsonMember) bool) (*jsonNode, int) {
+	for i, member := range n.members {
+		if cond(member) {
+			return n, i
+		}
+		if container, idx := member.value.find(cond); container != nil {
+			return container, idx
+		}
+	}
+	return nil, -1
+}
+
+// findValue returns the node that starts at the given byte offset.
+func (n *jsonNode) findValue(start int) *jsonNode {
+	if n.start == start {
+		return n
+	}
+	container, idx := n.find(func(m *jsonMember) bool { return m.value.start == start })
+	if container == nil {
+		return nil
+	}
+	return container.members[idx].value
+}
+
+// member returns the property with the given name.
+func (n *jsonNode) member(key string) *jsonMember {
+	for _, member := range n.members {
+		if member.key == key {
+			return member
+		}
+	}
+	return nil
+}
+
+// lineIndex converts byte offsets in a source to positions.
+// Line offsets are computed once, so the conversion does not scan the entire source.
+// Columns are counted in grapheme clusters in the same way as hclsyntax.
+type lineIndex struct {
+	source []byte
+	starts []int
+}
+
+func newLineIndex(source []byte) *lineIndex {
+	starts := []int{0}
+	for i, b := range source {
+		if b == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+	return &lineIndex{source: source, starts: starts}
+}
+
+// pos returns the position of the given byte offset.
+func (l *lineIndex) pos(offset int) hcl.Pos {
+	line := sort.Search(len(l.starts), func(i int) bool { return l.starts[i] > offset }) - 1
+
+	column := 1
+	for rest := l.source[l.starts[line]:offset]; len(rest) > 0; column++ {
+		advance, _, _ := textseg.ScanGraphemeClusters(rest, true)
+		if advance <= 0 {
+			advance = 1
+		}
+		rest = rest[advance:]
+	}
+	return hcl.Pos{Line: line + 1, Column: column, Byte: offset}
+}
+
+// posAt returns the position of the given byte offset in the original source.
+func (f *Fixer) posAt(filename string, offset int) hcl.Pos {
+	index, exists := f.lines[filename]
+	if !exists {
+		index = newLineIndex(f.sources[filename])
+		f.lines[filename] = index
+	}
+	return index.pos(offset)
+}
+
+// rangeAt returns the range between the given byte offsets in the original source.
+func (f *Fixer) rangeAt(filename string, start, end int) hcl.Range {
+	return hcl.Range{Filename: filename, Start: f.posAt(filename, start), End: f.posAt(filename, end)}
+}
+
+// formatJSON formats the given JSON content in the same style as the original source.
+// If the original source is on a single line, the content is left as it is.
+// Otherwise, it is indented with the indentation found in the original source.
+// The newline sequence and trailing newline of the original source are preserved.
+func formatJSON(original []byte, content []byte) ([]byte, error) {
+	trimmed := bytes.TrimSpace(original)
+	idx := bytes.IndexByte(trimmed, '\n')
+	if idx < 0 {
+		if !json.Valid(content) {
+			return nil, fmt.Errorf("invalid JSON")
+		}
+		return content, nil
+	}
+
+	indent := "  "
+	line := trimmed[idx+1:]
+	if width := len(line) - len(bytes.TrimLeft(line, " \t")); width > 0 {
+		indent = string(line[:width])
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, bytes.TrimSpace(content), "", indent); err != nil {
+		return nil, err
+	}
+	newline := newlineOf(original)
+	ret := []byte(convertNewlines(buf.String(), newline))
+	if bytes.HasSuffix(original, []byte("\n")) {
+		ret = append(ret, newline...)
+	}
+	return ret, nil
+}
@@ -0,0 +1,570 @@
+package internal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/json"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func TestRemoveAttribute_json(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		attr   string
+		want   string
+	}{
+		{
+			name: "remove the first property",
+			source: `{
+  "foo": 1,
+  "bar": 2
+}`,
+			attr: "foo",
+			want: `{
+  "bar": 2
+}`,
+		},
+		{
+			name: "remove the last property",
+			source: `{
+  "foo": 1,
+  "bar": 2
+}`,
+			attr: "bar",
+			want: `{
+  "foo": 1
+}`,
+		},
+		{
+			name:   "remove the middle property",
+			source: `{"foo": 1, "bar": {"baz": 2}, "qux": 3}`,
+			attr:   "bar",
+			want:   `{"foo": 1, "qux": 3}`,
+		},
+		{
+			name:   "remove the only property",
+			source: `{"foo": 1}`,
+			attr:   "foo",
+			want:   `{}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file, diags := json.Parse([]byte(test.source), "main.tf.json")
+			if diags.HasErrors() {
+				t.Fatalf("failed to parse JSON: %s", diags)
+			}
+			attrs, diags := file.Body.JustAttributes()
+			if diags.HasErrors() {
+				t.Fatal(diags)
+			}
+
+			fixer := NewFixer(map[string][]byte{"main.tf.json": []byte(test.source)})
+
+			if err := fixer.RemoveAttribute(attrs[test.attr]); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(test.want, string(fixer.changes["main.tf.json"])); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestRemoveBlock_json(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		index  int
+		want   string
+		err    error
+	}{
+		{
+			name: "remove a block with parents",
+			source: `{
+  "resource": {
+    "aws_instance": {
+      "main": {
+        "instance_type": "t2.micro"
+      }
+    }
+  },
+  "locals": {
+    "foo": 1
+  }
+}`,
+			want: `{
+  "locals": {
+    "foo": 1
+  }
+}`,
+		},
+		{
+			name: "remove a block with siblings",
+			source: `{
+  "resource": {
+    "aws_instance": {
+      "main": {
+        "instance_type": "t2.micro"
+      },
+      "sub": {
+        "instance_type": "t3.micro"
+      }
+    }
+  }
+}`,
+			want: `{
+  "resource": {
+    "aws_instance": {
+      "sub": {
+        "instance_type": "t3.micro"
+      }
+    }
+  }
+}`,
+		},
+		{
+			name: "remove a block in a single element array",
+			source: `{
+  "resource": {
+    "aws_instance": {
+      "main": [
+        {"instance_type": "t2.micro"}
+      ],
+      "sub": {}
+    }
+  }
+}`,
+			want: `{
+  "resource": {
+    "aws_instance": {
+      "sub": {}
+    }
+  }
+}`,
+		},
+		{
+			name: "remove a block in an array",
+			source: `{
+  "resource": {
+    "aws_instance": {
+      "main": [
+        {"instance_type": "t2.micro"},
+        {"instance_type": "t3.micro"}
+      ]
+    }
+  }
+}`,
+			index: 1,
+			want:  "",
+			err:   tflint.ErrFixNotSupported,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file, diags := json.Parse([]byte(test.source), "main.tf.json")
+			if diags.HasErrors() {
+				t.Fatalf("failed to parse JSON: %s", diags)
+			}
+			content, _, diags := file.Body.PartialContent(&hcl.BodySchema{
+				Blocks: []hcl.BlockHeaderSchema{{Type: "resource", LabelNames: []string{"type", "name"}}},
+			})
+			if diags.HasErrors() {
+				t.Fatal(diags)
+			}
+
+			fixer := NewFixer(map[string][]byte{"main.tf.json": []byte(test.source)})
+
+			if err := fixer.RemoveBlock(content.Blocks[test.index]); !errors.Is(err, test.err) {
+				t.Fatalf("expected %v, but got %v", test.err, err)
+			}
+
+			if diff := cmp.Diff(test.want, string(fixer.changes["main.tf.json"])); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestSetAttribute_json(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		attr   string
+		value  string
+		want   string
+	}{
+		{
+			name: "replace existing property",
+			source: `{
+  "resource": {
+    "aws_instance": {
+      "main": {
+        "instance_type": "t2.micro"
+      }
+    }
+  }
+}`,
+			attr:  "instance_type",
+			value: `"t3.micro"`,
+			want: `{
+  "resource": {
+    "aws_instance": {
+      "main": {
+        "instance_type": "t3.micro"
+      }
+    }
+  }
+}`,
+		},
+		{
+			name: "insert a property",
+			source: `{
+  "resource": {
+    "aws_instance": {
+      "main": {
+        "instance_type": "t2.micro"
+      }
+    }
+  }
+}`,
+			attr:  "tags",
+			value: `{ Name = "main" }`,
+			want: `{
+  "resource": {
+    "aws_instance": {
+      "main": {
+        "instance_type": "t2.micro",
+        "tags": {"Name":"main"}
+      }
+    }
+  }
+}`,
+		},
+		{
+			name:   "insert into an empty body",
+			source: `{"resource": {"aws_instance": {"main": {}}}}`,
+			attr:   "ami",
+			value:  "data.aws_ami.main.id",
+			want:   `{"resource": {"aws_instance": {"main": {"ami": "${data.aws_ami.main.id}"}}}}`,
+		},
+		{
+			name:   "insert into a single-line body",
+			source: `{"resource": {"aws_instance": {"main": {"ami": "ami-123"}}}}`,
+			attr:   "instance_type",
+			value:  `"${var.family}.micro"`,
+			want:   `{"resource": {"aws_instance": {"main": {"ami": "ami-123", "instance_type": "${var.family}.micro"}}}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file, diags := json.Parse([]byte(test.source), "main.tf.json")
+			if diags.HasErrors() {
+				t.Fatalf("failed to parse JSON: %s", diags)
+			}
+			content, diags := hclext.PartialContent(file.Body, &hclext.BodySchema{
+				Blocks: []hclext.BlockSchema{{Type: "resource", LabelNames: []string{"type", "name"}}},
+			})
+			if diags.HasErrors() {
+				t.Fatal(diags)
+			}
+
+			fixer := NewFixer(map[string][]byte{"main.tf.json": []byte(test.source)})
+
+			if err := fixer.SetAttribute(content.Blocks[0], test.attr, test.value); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(test.want, string(fixer.changes["main.tf.json"])); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestAppendBlock_json(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		snippet string
+		want    string
+		err     error
+	}{
+		{
+			name: "append a block",
+			source: `{
+  "resource": {
+    "aws_instance": {
+      "main": {
+        "instance_type": "t2.micro"
+      }
+    }
+  }
+}`,
+			snippet: `
+lifecycle {
+  create_before_destroy = true
+  prevent_destroy       = false
+}
+`,
+			want: `{
+  "resource": {
+    "aws_instance": {
+      "main": {
+        "instance_type": "t2.micro",
+        "lifecycle": {"create_before_destroy": true, "prevent_destroy": false}
+      }
+    }
+  }
+}`,
+		},
+		{
+			name:   "append a labeled block with nested blocks",
+			source: `{"resource": {"aws_instance": {"main": {}}}}`,
+			snippet: `provisioner "local-exec" {
+  command = "echo ${self.id}"
+  connection {
+    type = "ssh"
+  }
+}`,
+			want: `{"resource": {"aws_instance": {"main": {"provisioner": {"local-exec": {"command": "echo ${self.id}", "connection": {"type": "ssh"}}}}}}}`,
+		},
+		{
+			name:    "append to an array of blocks",
+			source:  `{"resource": {"aws_instance": {"main": {"ebs_block_device": [{"device_name": "sda"}]}}}}`,
+			snippet: `ebs_block_device { device_name = "sdb" }`,
+			want:    `{"resource": {"aws_instance": {"main": {"ebs_block_device": [{"device_name": "sda"}, {"device_name": "sdb"}]}}}}`,
+		},
+		{
+			name:    "append to an existing block",
+			source:  `{"resource": {"aws_instance": {"main": {"ebs_block_device": {"device_name": "sda"}}}}}`,
+			snippet: `ebs_block_device { device_name = "sdb" }`,
+			want:    `{"resource": {"aws_instance": {"main": {"ebs_block_device": [{"device_name": "sda"}, {"device_name": "sdb"}]}}}}`,
+		},
+		{
+			name:    "append to an existing label",
+			source:  `{"resource": {"aws_instance": {"main": {"provisioner": {"local-exec": {"command": "echo"}}}}}}`,
+			snippet: `provisioner "file" { source = "conf" }`,
+			want:    `{"resource": {"aws_instance": {"main": {"provisioner": {"local-exec": {"command": "echo"}, "file": {"source": "conf"}}}}}}`,
+		},
+		{
+			name:    "multiple blocks in an array",
+			source:  `{"resource": {"aws_instance": {"main": [{}, {}]}}}`,
+			snippet: `lifecycle {}`,
+			want:    "",
+			err:     tflint.ErrFixNotSupported,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file, diags := json.Parse([]byte(test.source), "main.tf.json")
+			if diags.HasErrors() {
+				t.Fatalf("failed to parse JSON: %s", diags)
+			}
+			content, diags := hclext.PartialContent(file.Body, &hclext.BodySchema{
+				Blocks: []hclext.BlockSchema{{Type: "resource", LabelNames: []string{"type", "name"}}},
+			})
+			if diags.HasErrors() {
+				t.Fatal(diags)
+			}
+
+			fixer := NewFixer(map[string][]byte{"main.tf.json": []byte(test.source)})
+
+			err := fixer.AppendBlock(content.Blocks[0], test.snippet)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expected error %v, got %v", test.err, err)
+			}
+
+			if diff := cmp.Diff(test.want, string(fixer.changes["main.tf.json"])); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestSetObjectItem_json(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		key    string
+		value  string
+		want   string
+	}{
+		{
+			name:   "replace existing item",
+			source: `{"tags": {"Name": "foo", "Env": "dev"}}`,
+			key:    "Env",
+			value:  `"prod"`,
+			want:   `{"tags": {"Name": "foo", "Env": "prod"}}`,
+		},
+		{
+			name: "insert an item",
+			source: `{
+  "tags": {
+    "Name": "foo"
+  }
+}`,
+			key:   "kubernetes.io/name",
+			value: `"${var.name}"`,
+			want: `{
+  "tags": {
+    "Name": "foo",
+    "kubernetes.io/name": "${var.name}"
+  }
+}`,
+		},
+		{
+			name:   "insert into an empty object",
+			source: `{"tags": {}}`,
+			key:    "Count",
+			value:  `1`,
+			want:   `{"tags": {"Count": 1}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file, diags := json.Parse([]byte(test.source), "main.tf.json")
+			if diags.HasErrors() {
+				t.Fatalf("failed to parse JSON: %s", diags)
+			}
+			attrs, diags := file.Body.JustAttributes()
+			if diags.HasErrors() {
+				t.Fatal(diags)
+			}
+
+			fixer := NewFixer(map[string][]byte{"main.tf.json": []byte(test.source)})
+
+			if err := fixer.SetObjectItem(attrs["tags"].Expr, test.key, test.value); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(test.want, string(fixer.changes["main.tf.json"])); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestJSONValueText(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{
+			name:  "string",
+			value: `"foo"`,
+			want:  `"foo"`,
+		},
+		{
+			name:  "number",
+			value: `1.5`,
+			want:  `1.5`,
+		},
+		{
+			name:  "bool",
+			value: `true`,
+			want:  `true`,
+		},
+		{
+			name:  "null",
+			value: `null`,
+			want:  `null`,
+		},
+		{
+			name:  "object",
+			value: `{ foo = [1, 2], bar = "baz" }`,
+			want:  `{"bar":"baz","foo":[1,2]}`,
+		},
+		{
+			name:  "escaped template sequence",
+			value: `"$${foo}"`,
+			want:  `"$${foo}"`,
+		},
+		{
+			name:  "reference",
+			value: `var.foo`,
+			want:  `"${var.foo}"`,
+		},
+		{
+			name:  "template",
+			value: `"${var.foo}-bar"`,
+			want:  `"${var.foo}-bar"`,
+		},
+		{
+			name:  "function call",
+			value: `max(var.a, 1) > 0`,
+			want:  `"${max(var.a, 1) > 0}"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := jsonValueText(test.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Errorf("got %s, but want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFormatChanges_json(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		changed string
+		want    string
+	}{
+		{
+			name:    "indented",
+			source:  "{\n  \"foo\": 1\n}\n",
+			changed: "{\n  \"foo\": 1,\n  \"tags\": {\"Name\":\"main\"}\n}\n",
+			want:    "{\n  \"foo\": 1,\n  \"tags\": {\n    \"Name\": \"main\"\n  }\n}\n",
+		},
+		{
+			name:    "tab indented",
+			source:  "{\n\t\"foo\": 1\n}",
+			changed: "{\n\t\"foo\": 1, \"bar\": 2\n}",
+			want:    "{\n\t\"foo\": 1,\n\t\"bar\": 2\n}",
+		},
+		{
+			name:    "single line",
+			source:  `{"foo": 1}`,
+			changed: `{"foo": 1, "bar": 2}`,
+			want:    `{"foo": 1, "bar": 2}`,
+		},
+		{
+			name:    "broken",
+			source:  "{\n  \"foo\": 1\n}",
+			changed: "{\n  \"foo\": 1,\n}",
+			want:    "{\n  \"foo\": 1,\n}",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fixer := NewFixer(map[string][]byte{"main.tf.json": []byte(test.source)})
+			fixer.changes["main.tf.json"] = []byte(test.changed)
+
+			fixer.FormatChanges()
+
+			if diff := cmp.Diff(test.want, string(fixer.changes["main.tf.json"])); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
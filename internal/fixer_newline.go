@@ -0,0 +1,38 @@
+package internal
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// utf8BOM is the byte order mark of UTF-8.
+var utf8BOM = []byte("\xEF\xBB\xBF")
+
+// newlineOf returns the newline sequence used in the source.
+// The first line ending determines the convention. If there are no newlines, it returns "\n".
+func newlineOf(source []byte) string {
+	if idx := bytes.IndexByte(source, '\n'); idx > 0 && source[idx-1] == '\r' {
+		return "\r\n"
+	}
+	return "\n"
+}
+
+// convertNewlines converts newlines in the text to the given newline sequence.
+func convertNewlines(text string, newline string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	if newline == "\n" {
+		return text
+	}
+	return strings.ReplaceAll(text, "\n", newline)
+}
+
+// formatHCL formats the given HCL content.
+// Unlike hclwrite.Format, the BOM is preserved.
+func formatHCL(content []byte) []byte {
+	if bytes.HasPrefix(content, utf8BOM) {
+		return append(bytes.Clone(utf8BOM), hclwrite.Format(content[len(utf8BOM):])...)
+	}
+	return hclwrite.Format(content)
+}
@@ -0,0 +1,115 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/json"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+)
+
+func TestFixPreservesNewlinesAndBOM(t *testing.T) {
+	// parseBlock returns the first block of the source as *hclext.Block.
+	parseBlock := func(t *testing.T, source string) *hclext.Block {
+		file, diags := hclsyntax.ParseConfig([]byte(source), "main.tf", hcl.InitialPos)
+		if diags.HasErrors() {
+			t.Fatal(diags)
+		}
+		block := file.Body.(*hclsyntax.Body).Blocks[0]
+		return &hclext.Block{Type: block.Type, DefRange: block.DefRange(), TypeRange: block.TypeRange}
+	}
+
+	tests := []struct {
+		name     string
+		filename string
+		source   string
+		fix      func(*testing.T, *Fixer, string) error
+		want     string
+	}{
+		{
+			name:     "insert lines into CRLF",
+			filename: "main.tf",
+			source:   "block {\r\n  foo = 1\r\n}\r\n",
+			fix: func(t *testing.T, fixer *Fixer, source string) error {
+				return fixer.SetAttribute(parseBlock(t, source), "barbaz", "{\n  a = 1\n}")
+			},
+			want: "block {\r\n  foo = 1\r\n  barbaz = {\r\n    a = 1\r\n  }\r\n}\r\n",
+		},
+		{
+			name:     "append block into CRLF",
+			filename: "main.tf",
+			source:   "block {\r\n  foo = 1\r\n}",
+			fix: func(t *testing.T, fixer *Fixer, source string) error {
+				return fixer.AppendBlock(parseBlock(t, source), "nested {\n  bar = 2\n}\n")
+			},
+			want: "block {\r\n  foo = 1\r\n\r\n  nested {\r\n    bar = 2\r\n  }\r\n}",
+		},
+		{
+			name:     "remove attribute from CRLF",
+			filename: "main.tf",
+			source:   "block {\r\n  foo = 1\r\n  bar = 2\r\n}\r\n",
+			fix: func(t *testing.T, fixer *Fixer, source string) error {
+				file, diags := hclsyntax.ParseConfig([]byte(source), "main.tf", hcl.InitialPos)
+				if diags.HasErrors() {
+					t.Fatal(diags)
+				}
+				attr := file.Body.(*hclsyntax.Body).Blocks[0].Body.Attributes["foo"]
+				return fixer.RemoveAttribute(attr.AsHCLAttribute())
+			},
+			want: "block {\r\n  bar = 2\r\n}\r\n",
+		},
+		{
+			name:     "LF text into LF",
+			filename: "main.tf",
+			source:   "block {\n  foo = 1\n}\n",
+			fix: func(t *testing.T, fixer *Fixer, source string) error {
+				return fixer.SetAttribute(parseBlock(t, source), "bar", "[\r\n  1,\r\n]")
+			},
+			want: "block {\n  foo = 1\n  bar = [\n    1,\n  ]\n}\n",
+		},
+		{
+			name:     "BOM",
+			filename: "main.tf",
+			source:   "\xEF\xBB\xBFblock {\n  foo = 1\n}\n",
+			fix: func(t *testing.T, fixer *Fixer, source string) error {
+				return fixer.SetAttribute(parseBlock(t, source), "barbaz", "2")
+			},
+			want: "\xEF\xBB\xBFblock {\n  foo    = 1\n  barbaz = 2\n}\n",
+		},
+		{
+			name:     "JSON with CRLF",
+			filename: "main.tf.json",
+			source:   "{\r\n  \"block\": {\r\n    \"foo\": 1\r\n  }\r\n}\r\n",
+			fix: func(t *testing.T, fixer *Fixer, source string) error {
+				file, diags := json.Parse([]byte(source), "main.tf.json")
+				if diags.HasErrors() {
+					t.Fatal(diags)
+				}
+				content, diags := file.Body.Content(&hcl.BodySchema{Blocks: []hcl.BlockHeaderSchema{{Type: "block"}}})
+				if diags.HasErrors() {
+					t.Fatal(diags)
+				}
+				block := content.Blocks[0]
+				return fixer.SetAttribute(&hclext.Block{Type: block.Type, Body: &hclext.BodyContent{}, DefRange: block.DefRange, TypeRange: block.TypeRange}, "bar", "2")
+			},
+			want: "{\r\n  \"block\": {\r\n    \"foo\": 1,\r\n    \"bar\": 2\r\n  }\r\n}\r\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fixer := NewFixer(map[string][]byte{test.filename: []byte(test.source)})
+
+			if err := test.fix(t, fixer, test.source); err != nil {
+				t.Fatal(err)
+			}
+			fixer.FormatChanges()
+
+			if diff := cmp.Diff(test.want, string(fixer.Changes()[test.filename])); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
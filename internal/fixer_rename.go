@@ -0,0 +1,284 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/terraform"
+	"github.com/terraform-linters/tflint-plugin-sdk/terraform/addrs"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// Rename renames the object of the given address declared in the module to the given name,
+// and rewrites all references to it. If the object is a resource or a module call,
+// a moved block is added after the declaration so that Terraform does not destroy it.
+//
+// The address is one of the following formats:
+//
+//   - <TYPE>.<NAME> (resources)
+//   - data.<TYPE>.<NAME> (data sources)
+//   - var.<NAME> (input variables)
+//   - local.<NAME> (local values)
+//   - output.<NAME> (outputs)
+//   - module.<NAME> (module calls)
+//
+// References in moved and removed blocks are not rewritten because they refer to previous addresses.
+// If the module contains files in JSON syntax, it returns ErrFixNotSupported.
+func (f *Fixer) Rename(addr string, name string) error {
+	if !hclsyntax.ValidIdentifier(name) {
+		return fmt.Errorf(`"%s" is not a valid name`, name)
+	}
+	target, err := parseRenameTarget(addr)
+	if err != nil {
+		return err
+	}
+	if target.name == name {
+		return nil
+	}
+
+	filenames := make([]string, 0, len(f.sources))
+	for filename := range f.sources {
+		// References in JSON syntax cannot be rewritten safely.
+		if terraform.IsJSONFilename(filename) {
+			return tflint.ErrFixNotSupported
+		}
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	bodies := make([]*hclsyntax.Body, len(filenames))
+	for i, filename := range filenames {
+		file, diags := hclsyntax.ParseConfig(f.sources[filename], filename, hcl.InitialPos)
+		if diags.HasErrors() {
+			return diags
+		}
+		bodies[i] = file.Body.(*hclsyntax.Body)
+	}
+
+	if err := f.renameDeclaration(bodies, target, name); err != nil {
+		return err
+	}
+
+	for _, body := range bodies {
+		for _, block := range body.Blocks {
+			if block.Type == "moved" || block.Type == "removed" {
+				continue
+			}
+			if err := f.renameReferencesInBody(block.Body, target, name); err != nil {
+				return err
+			}
+		}
+		// Top-level attributes are not valid in Terraform, but rewrite them just in case.
+		if err := f.renameReferencesInAttributes(body.Attributes, target, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renameTarget is an object to be renamed.
+type renameTarget struct {
+	// blockType is the type of the declaring block. For local values, this is "locals".
+	blockType string
+	// resourceType is the type of resources and data sources.
+	resourceType string
+	name         string
+}
+
+func parseRenameTarget(addr string) (*renameTarget, error) {
+	traversal, diags := hclsyntax.ParseTraversalAbs([]byte(addr), "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf(`"%s" is not a valid address: %w`, addr, diags)
+	}
+
+	// Outputs are not valid as references, so parse them here.
+	if traversal.RootName() == "output" {
+		if len(traversal) != 2 {
+			return nil, fmt.Errorf(`"%s" is not a valid output address`, addr)
+		}
+		if attr, ok := traversal[1].(hcl.TraverseAttr); ok {
+			return &renameTarget{blockType: "output", name: attr.Name}, nil
+		}
+		return nil, fmt.Errorf(`"%s" is not a valid output address`, addr)
+	}
+
+	ref, diags := addrs.ParseRef(traversal)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf(`"%s" is not a valid address: %w`, addr, diags)
+	}
+	if len(ref.Remaining) > 0 {
+		return nil, fmt.Errorf(`"%s" is not an address of a renameable object`, addr)
+	}
+
+	switch subject := ref.Subject.(type) {
+	case addrs.Resource:
+		if subject.Mode == addrs.DataResourceMode {
+			return &renameTarget{blockType: "data", resourceType: subject.Type, name: subject.Name}, nil
+		}
+		return &renameTarget{blockType: "resource", resourceType: subject.Type, name: subject.Name}, nil
+	case addrs.InputVariable:
+		return &renameTarget{blockType: "variable", name: subject.Name}, nil
+	case addrs.LocalValue:
+		return &renameTarget{blockType: "locals", name: subject.Name}, nil
+	case addrs.ModuleCall:
+		return &renameTarget{blockType: "module", name: subject.Name}, nil
+	default:
+		return nil, fmt.Errorf(`"%s" is not an address of a renameable object`, addr)
+	}
+}
+
+// address returns the address of the target with the given name.
+func (t *renameTarget) address(name string) string {
+	switch t.blockType {
+	case "resource":
+		return fmt.Sprintf("%s.%s", t.resourceType, name)
+	case "data":
+		return fmt.Sprintf("data.%s.%s", t.resourceType, name)
+	case "variable":
+		return "var." + name
+	case "locals":
+		return "local." + name
+	default:
+		return fmt.Sprintf("%s.%s", t.blockType, name)
+	}
+}
+
+// declaredBy returns true if the block declares the target with the given name.
+func (t *renameTarget) declaredBy(block *hclsyntax.Block, name string) bool {
+	if block.Type != t.blockType {
+		return false
+	}
+	switch t.blockType {
+	case "resource", "data":
+		return len(block.Labels) == 2 && block.Labels[0] == t.resourceType && block.Labels[1] == name
+	default:
+		return len(block.Labels) == 1 && block.Labels[0] == name
+	}
+}
+
+// referencedBy returns true if the reference refers to the target.
+func (t *renameTarget) referencedBy(ref *addrs.Reference) bool {
+	switch subject := ref.Subject.(type) {
+	case addrs.Resource:
+		return t.matchesResource(subject)
+	case addrs.ResourceInstance:
+		return t.matchesResource(subject.Resource)
+	case addrs.InputVariable:
+		return t.blockType == "variable" && subject.Name == t.name
+	case addrs.LocalValue:
+		return t.blockType == "locals" && subject.Name == t.name
+	case addrs.ModuleCall:
+		return t.blockType == "module" && subject.Name == t.name
+	case addrs.ModuleCallInstance:
+		return t.blockType == "module" && subject.Call.Name == t.name
+	case addrs.ModuleCallInstanceOutput:
+		return t.blockType == "module" && subject.Call.Call.Name == t.name
+	default:
+		return false
+	}
+}
+
+func (t *renameTarget) matchesResource(resource addrs.Resource) bool {
+	switch resource.Mode {
+	case addrs.ManagedResourceMode:
+		return t.blockType == "resource" && resource.Type == t.resourceType && resource.Name == t.name
+	case addrs.DataResourceMode:
+		return t.blockType == "data" && resource.Type == t.resourceType && resource.Name == t.name
+	default:
+		return false
+	}
+}
+
+// renameDeclaration renames the declaration of the target, and adds a moved block if needed.
+func (f *Fixer) renameDeclaration(bodies []*hclsyntax.Body, target *renameTarget, name string) error {
+	if target.blockType == "locals" {
+		var decl *hclsyntax.Attribute
+		for _, body := range bodies {
+			for _, block := range body.Blocks {
+				if block.Type != "locals" {
+					continue
+				}
+				if _, exists := block.Body.Attributes[name]; exists {
+					return fmt.Errorf("%s is already declared", target.address(name))
+				}
+				if attr, exists := block.Body.Attributes[target.name]; exists {
+					decl = attr
+				}
+			}
+		}
+		if decl == nil {
+			return fmt.Errorf("%s is not declared", target.address(target.name))
+		}
+		return f.ReplaceText(decl.NameRange, name)
+	}
+
+	var decl *hclsyntax.Block
+	for _, body := range bodies {
+		for _, block := range body.Blocks {
+			if target.declaredBy(block, name) {
+				return fmt.Errorf("%s is already declared", target.address(name))
+			}
+			if target.declaredBy(block, target.name) {
+				decl = block
+			}
+		}
+	}
+	if decl == nil {
+		return fmt.Errorf("%s is not declared", target.address(target.name))
+	}
+
+	// Labels can be either quoted or unquoted. Keep the style.
+	labelRange := decl.LabelRanges[len(decl.LabelRanges)-1]
+	label := name
+	if f.sources[labelRange.Filename][labelRange.Start.Byte] == '"' {
+		label = fmt.Sprintf(`"%s"`, name)
+	}
+	if err := f.ReplaceText(labelRange, label); err != nil {
+		return err
+	}
+
+	// Terraform supports moved blocks only for resources and module calls.
+	if target.blockType != "resource" && target.blockType != "module" {
+		return nil
+	}
+	moved := fmt.Sprintf("\n\nmoved {\n  from = %s\n  to   = %s\n}", target.address(target.name), target.address(name))
+	return f.InsertTextAfter(decl.Range(), moved)
+}
+
+func (f *Fixer) renameReferencesInBody(body *hclsyntax.Body, target *renameTarget, name string) error {
+	if err := f.renameReferencesInAttributes(body.Attributes, target, name); err != nil {
+		return err
+	}
+	for _, block := range body.Blocks {
+		if err := f.renameReferencesInBody(block.Body, target, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *Fixer) renameReferencesInAttributes(attributes hclsyntax.Attributes, target *renameTarget, name string) error {
+	for _, attr := range attributes {
+		for _, traversal := range attr.Expr.Variables() {
+			ref, diags := addrs.ParseRef(traversal)
+			if diags.HasErrors() || !target.referencedBy(ref) {
+				continue
+			}
+
+			// The name follows the root, except for references like "data.TYPE.NAME" and "resource.TYPE.NAME".
+			// Note that the source range of the step includes the preceding dot.
+			step := traversal[1]
+			if root := traversal.RootName(); root == "data" || root == "resource" {
+				step = traversal[2]
+			}
+			rng := step.SourceRange()
+
+			if err := f.ReplaceText(f.rangeAt(rng.Filename, rng.End.Byte-len(target.name), rng.End.Byte), name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
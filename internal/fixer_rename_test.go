@@ -0,0 +1,385 @@
+package internal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func TestRename(t *testing.T) {
+	// default error check helper
+	neverHappend := func(err error) bool { return err != nil }
+
+	tests := []struct {
+		name     string
+		sources  map[string]string
+		addr     string
+		to       string
+		want     map[string]string
+		errCheck func(error) bool
+	}{
+		{
+			name: "resource",
+			sources: map[string]string{
+				"main.tf": `resource "aws_s3_bucket" "Foo" {
+  bucket = "foo"
+}
+
+resource "aws_s3_bucket_policy" "main" {
+  bucket = aws_s3_bucket.Foo.id
+  policy = jsonencode({ Resource = "${aws_s3_bucket.Foo.arn}/*" })
+
+  depends_on = [aws_s3_bucket.Foo]
+}
+`,
+				"outputs.tf": `output "bucket" {
+  value = aws_s3_bucket . Foo[0].id
+}
+`,
+			},
+			addr: "aws_s3_bucket.Foo",
+			to:   "foo",
+			want: map[string]string{
+				"main.tf": `resource "aws_s3_bucket" "foo" {
+  bucket = "foo"
+}
+
+moved {
+  from = aws_s3_bucket.Foo
+  to   = aws_s3_bucket.foo
+}
+
+resource "aws_s3_bucket_policy" "main" {
+  bucket = aws_s3_bucket.foo.id
+  policy = jsonencode({ Resource = "${aws_s3_bucket.foo.arn}/*" })
+
+  depends_on = [aws_s3_bucket.foo]
+}
+`,
+				"outputs.tf": `output "bucket" {
+  value = aws_s3_bucket . foo[0].id
+}
+`,
+			},
+			errCheck: neverHappend,
+		},
+		{
+			name: "resource with the same name of another type",
+			sources: map[string]string{
+				"main.tf": `resource "aws_instance" "Foo" {}
+resource "aws_s3_bucket" "Foo" {}
+
+locals {
+  ids = [aws_instance.Foo.id, resource.aws_s3_bucket.Foo.id, data.aws_s3_bucket.Foo.id]
+}
+`,
+			},
+			addr: "aws_s3_bucket.Foo",
+			to:   "foo",
+			want: map[string]string{
+				"main.tf": `resource "aws_instance" "Foo" {}
+resource "aws_s3_bucket" "foo" {}
+
+moved {
+  from = aws_s3_bucket.Foo
+  to   = aws_s3_bucket.foo
+}
+
+locals {
+  ids = [aws_instance.Foo.id, resource.aws_s3_bucket.foo.id, data.aws_s3_bucket.Foo.id]
+}
+`,
+			},
+			errCheck: neverHappend,
+		},
+		{
+			name: "existing moved blocks",
+			sources: map[string]string{
+				"main.tf": `resource "aws_s3_bucket" "Foo" {}
+
+moved {
+  from = aws_s3_bucket.old
+  to   = aws_s3_bucket.Foo
+}
+
+import {
+  to = aws_s3_bucket.Foo
+  id = "foo"
+}
+`,
+			},
+			addr: "aws_s3_bucket.Foo",
+			to:   "foo",
+			want: map[string]string{
+				"main.tf": `resource "aws_s3_bucket" "foo" {}
+
+moved {
+  from = aws_s3_bucket.Foo
+  to   = aws_s3_bucket.foo
+}
+
+moved {
+  from = aws_s3_bucket.old
+  to   = aws_s3_bucket.Foo
+}
+
+import {
+  to = aws_s3_bucket.foo
+  id = "foo"
+}
+`,
+			},
+			errCheck: neverHappend,
+		},
+		{
+			name: "data source",
+			sources: map[string]string{
+				"main.tf": `data "aws_ami" "Ubuntu" {}
+
+resource "aws_instance" "main" {
+  ami = data.aws_ami.Ubuntu.id
+}
+`,
+			},
+			addr: "data.aws_ami.Ubuntu",
+			to:   "ubuntu",
+			want: map[string]string{
+				"main.tf": `data "aws_ami" "ubuntu" {}
+
+resource "aws_instance" "main" {
+  ami = data.aws_ami.ubuntu.id
+}
+`,
+			},
+			errCheck: neverHappend,
+		},
+		{
+			name: "variable",
+			sources: map[string]string{
+				"variables.tf": `variable "instanceType" {
+  validation {
+    condition     = var.instanceType != ""
+    error_message = "Must not be empty."
+  }
+}
+`,
+				"main.tf": `resource "aws_instance" "main" {
+  instance_type = var.instanceType
+  tags          = { for k, v in var.tags : k => "${v}-${var.instanceType}" }
+}
+`,
+			},
+			addr: "var.instanceType",
+			to:   "instance_type",
+			want: map[string]string{
+				"variables.tf": `variable "instance_type" {
+  validation {
+    condition     = var.instance_type != ""
+    error_message = "Must not be empty."
+  }
+}
+`,
+				"main.tf": `resource "aws_instance" "main" {
+  instance_type = var.instance_type
+  tags          = { for k, v in var.tags : k => "${v}-${var.instance_type}" }
+}
+`,
+			},
+			errCheck: neverHappend,
+		},
+		{
+			name: "local value",
+			sources: map[string]string{
+				"main.tf": `locals {
+  Name = "foo"
+  tags = { Name = local.Name }
+}
+`,
+			},
+			addr: "local.Name",
+			to:   "name",
+			want: map[string]string{
+				"main.tf": `locals {
+  name = "foo"
+  tags = { Name = local.name }
+}
+`,
+			},
+			errCheck: neverHappend,
+		},
+		{
+			name: "output",
+			sources: map[string]string{
+				"main.tf": `output "ID" {
+  value = "foo"
+}
+`,
+			},
+			addr: "output.ID",
+			to:   "id",
+			want: map[string]string{
+				"main.tf": `output "id" {
+  value = "foo"
+}
+`,
+			},
+			errCheck: neverHappend,
+		},
+		{
+			name: "module call",
+			sources: map[string]string{
+				"main.tf": `module "VPC" {
+  source = "./modules/vpc"
+}
+
+output "vpc_id" {
+  value = module.VPC.id
+}
+
+output "vpcs" {
+  value = [module.VPC, module.VPC[0]]
+}
+`,
+			},
+			addr: "module.VPC",
+			to:   "vpc",
+			want: map[string]string{
+				"main.tf": `module "vpc" {
+  source = "./modules/vpc"
+}
+
+moved {
+  from = module.VPC
+  to   = module.vpc
+}
+
+output "vpc_id" {
+  value = module.vpc.id
+}
+
+output "vpcs" {
+  value = [module.vpc, module.vpc[0]]
+}
+`,
+			},
+			errCheck: neverHappend,
+		},
+		{
+			name: "unquoted labels",
+			sources: map[string]string{
+				"main.tf": `variable Foo {}
+`,
+			},
+			addr: "var.Foo",
+			to:   "foo",
+			want: map[string]string{
+				"main.tf": `variable foo {}
+`,
+			},
+			errCheck: neverHappend,
+		},
+		{
+			name: "same name",
+			sources: map[string]string{
+				"main.tf": `variable "foo" {}`,
+			},
+			addr:     "var.foo",
+			to:       "foo",
+			want:     map[string]string{},
+			errCheck: neverHappend,
+		},
+		{
+			name: "not declared",
+			sources: map[string]string{
+				"main.tf": `variable "foo" {}`,
+			},
+			addr: "var.bar",
+			to:   "baz",
+			want: map[string]string{},
+			errCheck: func(err error) bool {
+				return err == nil || err.Error() != "var.bar is not declared"
+			},
+		},
+		{
+			name: "already declared",
+			sources: map[string]string{
+				"main.tf": `locals {
+  Foo = 1
+}
+
+locals {
+  foo = 2
+}
+`,
+			},
+			addr: "local.Foo",
+			to:   "foo",
+			want: map[string]string{},
+			errCheck: func(err error) bool {
+				return err == nil || err.Error() != "local.foo is already declared"
+			},
+		},
+		{
+			name: "invalid name",
+			sources: map[string]string{
+				"main.tf": `variable "foo" {}`,
+			},
+			addr: "var.foo",
+			to:   "foo-bar baz",
+			want: map[string]string{},
+			errCheck: func(err error) bool {
+				return err == nil || err.Error() != `"foo-bar baz" is not a valid name`
+			},
+		},
+		{
+			name: "not renameable",
+			sources: map[string]string{
+				"main.tf": `variable "foo" {}`,
+			},
+			addr: "path.module",
+			to:   "foo",
+			want: map[string]string{},
+			errCheck: func(err error) bool {
+				return err == nil || err.Error() != `"path.module" is not an address of a renameable object`
+			},
+		},
+		{
+			name: "JSON syntax",
+			sources: map[string]string{
+				"main.tf":      `variable "foo" {}`,
+				"main.tf.json": `{"locals": {"bar": "${var.foo}"}}`,
+			},
+			addr: "var.foo",
+			to:   "bar",
+			want: map[string]string{},
+			errCheck: func(err error) bool {
+				return !errors.Is(err, tflint.ErrFixNotSupported)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sources := map[string][]byte{}
+			for filename, source := range test.sources {
+				sources[filename] = []byte(source)
+			}
+			fixer := NewFixer(sources)
+
+			err := fixer.Rename(test.addr, test.to)
+			if test.errCheck(err) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := map[string]string{}
+			for filename, content := range fixer.changes {
+				got[filename] = string(content)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package internal
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -1343,6 +1345,482 @@ block {
 	}
 }
 
+func TestSetAttribute(t *testing.T) {
+	// default error check helper
+	neverHappend := func(err error) bool { return err != nil }
+
+	tests := []struct {
+		name     string
+		source   string
+		attr     string
+		value    string
+		want     string
+		errCheck func(error) bool
+	}{
+		{
+			name: "replace existing attribute",
+			source: `
+block {
+  foo = 1
+  bar = 2
+}`,
+			attr:  "foo",
+			value: "3",
+			want: `
+block {
+  foo = 3
+  bar = 2
+}`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "insert after the last attribute",
+			source: `
+block {
+  foo = 1 # comment
+  bar = 2
+
+  nested {
+    baz = 3
+  }
+}`,
+			attr:  "tags",
+			value: "{}",
+			want: `
+block {
+  foo = 1 # comment
+  bar = 2
+  tags = {}
+
+  nested {
+    baz = 3
+  }
+}`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "insert a multi-line value into a nested block",
+			source: `
+resource "foo" "bar" {
+  block {
+    foo = 1
+  }
+}`,
+			attr:  "tags",
+			value: "{\n  Name = \"foo\"\n}",
+			want: `
+resource "foo" "bar" {
+  block {
+    foo = 1
+    tags = {
+      Name = "foo"
+    }
+  }
+}`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "replace with a multi-line value including heredocs",
+			source: `
+resource "foo" "bar" {
+  block {
+    foo = 1
+  }
+}`,
+			attr:  "foo",
+			value: "{\n  a = <<EOT\nhello\n  world\nEOT\n  b = 2\n}",
+			want: `
+resource "foo" "bar" {
+  block {
+    foo = {
+      a = <<EOT
+hello
+  world
+EOT
+      b = 2
+    }
+  }
+}`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "insert into a body without attributes",
+			source: `
+block {
+  nested {}
+}`,
+			attr:  "foo",
+			value: `"bar"`,
+			want: `
+block {
+  foo = "bar"
+  nested {}
+}`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "insert into an empty body",
+			source: `
+block {
+}`,
+			attr:  "foo",
+			value: `"bar"`,
+			want: `
+block {
+  foo = "bar"
+}`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "insert into an inline body",
+			source: `
+block { foo = 1 }`,
+			attr:  "bar",
+			value: "2",
+			want: `
+block {
+  foo = 1
+  bar = 2
+}`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "insert into an empty inline body",
+			source: `
+block {}`,
+			attr:  "bar",
+			value: "2",
+			want: `
+block {
+  bar = 2
+}`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "insert into a nested block",
+			source: `
+parent {
+  block {
+    foo = 1
+  }
+}`,
+			attr:  "bar",
+			value: "2",
+			want: `
+parent {
+  block {
+    foo = 1
+    bar = 2
+  }
+}`,
+			errCheck: neverHappend,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file, diags := hclsyntax.ParseConfig([]byte(test.source), "main.tf", hcl.InitialPos)
+			if diags.HasErrors() {
+				t.Fatalf("failed to parse HCL: %s", diags)
+			}
+			blocks := []*hclext.Block{}
+			diags = hclsyntax.VisitAll(file.Body.(*hclsyntax.Body), func(node hclsyntax.Node) hcl.Diagnostics {
+				if block, ok := node.(*hclsyntax.Block); ok && block.Type == "block" {
+					blocks = append(blocks, &hclext.Block{Type: block.Type, DefRange: block.DefRange(), TypeRange: block.TypeRange})
+				}
+				return nil
+			})
+			if diags.HasErrors() {
+				t.Fatal(diags)
+			}
+
+			fixer := NewFixer(map[string][]byte{"main.tf": []byte(test.source)})
+
+			err := fixer.SetAttribute(blocks[0], test.attr, test.value)
+			if test.errCheck(err) {
+				t.Fatalf("failed to check error: %s", err)
+			}
+
+			if diff := cmp.Diff(test.want, string(fixer.changes["main.tf"])); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestAppendBlock(t *testing.T) {
+	// default error check helper
+	neverHappend := func(err error) bool { return err != nil }
+
+	tests := []struct {
+		name     string
+		source   string
+		snippet  string
+		want     string
+		errCheck func(error) bool
+	}{
+		{
+			name: "append to a body",
+			source: `
+resource "aws_instance" "main" {
+  instance_type = "t2.micro"
+}`,
+			snippet: `
+lifecycle {
+  create_before_destroy = true
+}
+`,
+			want: `
+resource "aws_instance" "main" {
+  instance_type = "t2.micro"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "append to an empty body",
+			source: `
+resource "aws_instance" "main" {
+}`,
+			snippet: `lifecycle {}`,
+			want: `
+resource "aws_instance" "main" {
+  lifecycle {}
+}`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "append to an inline body",
+			source: `
+resource "aws_instance" "main" {}`,
+			snippet: `lifecycle {
+  create_before_destroy = true
+}`,
+			want: `
+resource "aws_instance" "main" {
+  lifecycle {
+    create_before_destroy = true
+  }
+}`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "invalid snippet",
+			source: `
+resource "aws_instance" "main" {}`,
+			snippet: `lifecycle {`,
+			want:    "",
+			errCheck: func(err error) bool {
+				return err == nil
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file, diags := hclsyntax.ParseConfig([]byte(test.source), "main.tf", hcl.InitialPos)
+			if diags.HasErrors() {
+				t.Fatalf("failed to parse HCL: %s", diags)
+			}
+			content, diags := hclext.PartialContent(file.Body, &hclext.BodySchema{
+				Blocks: []hclext.BlockSchema{{Type: "resource", LabelNames: []string{"type", "name"}}},
+			})
+			if diags.HasErrors() {
+				t.Fatal(diags)
+			}
+
+			fixer := NewFixer(map[string][]byte{"main.tf": []byte(test.source)})
+
+			err := fixer.AppendBlock(content.Blocks[0], test.snippet)
+			if test.errCheck(err) {
+				t.Fatalf("failed to check error: %s", err)
+			}
+
+			if diff := cmp.Diff(test.want, string(fixer.changes["main.tf"])); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestSetObjectItem(t *testing.T) {
+	// default error check helper
+	neverHappend := func(err error) bool { return err != nil }
+
+	tests := []struct {
+		name     string
+		source   string
+		key      string
+		value    string
+		want     string
+		errCheck func(error) bool
+	}{
+		{
+			name: "replace existing item",
+			source: `
+tags = {
+  Name = "foo"
+  Env  = "dev"
+}`,
+			key:   "Env",
+			value: `"prod"`,
+			want: `
+tags = {
+  Name = "foo"
+  Env  = "prod"
+}`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "replace existing quoted item",
+			source: `
+tags = { "Name" = "foo" }`,
+			key:   "Name",
+			value: `"bar"`,
+			want: `
+tags = { "Name" = "bar" }`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "insert a multi-line value into a multi-line object",
+			source: `
+tags = {
+  Name = "foo"
+}`,
+			key:   "Extra",
+			value: "{\n  Env = \"prod\"\n}",
+			want: `
+tags = {
+  Name = "foo"
+  Extra = {
+    Env = "prod"
+  }
+}`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "insert into a multi-line object",
+			source: `
+tags = {
+  Name = "foo"
+}`,
+			key:   "Env",
+			value: `"prod"`,
+			want: `
+tags = {
+  Name = "foo"
+  Env = "prod"
+}`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "insert into a multi-line object closed on the same line",
+			source: `
+tags = {
+  Name = "foo" }`,
+			key:   "Env",
+			value: `"prod"`,
+			want: `
+tags = {
+  Name = "foo"
+  Env = "prod"
+}`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "insert into an empty multi-line object",
+			source: `
+tags = {
+}`,
+			key:   "Env",
+			value: `"prod"`,
+			want: `
+tags = {
+  Env = "prod"
+}`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "insert into a single-line object",
+			source: `
+tags = { Name = "foo" }`,
+			key:   "Env",
+			value: `"prod"`,
+			want: `
+tags = { Name = "foo", Env = "prod" }`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "insert into a single-line object with a trailing comma",
+			source: `
+tags = { Name = "foo", }`,
+			key:   "Env",
+			value: `"prod"`,
+			want: `
+tags = { Name = "foo", Env = "prod" }`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "insert into an empty object",
+			source: `
+tags = {}`,
+			key:   "Env",
+			value: `"prod"`,
+			want: `
+tags = { Env = "prod" }`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "insert a key that is not an identifier",
+			source: `
+tags = {}`,
+			key:   "kubernetes.io/name",
+			value: `"foo"`,
+			want: `
+tags = { "kubernetes.io/name" = "foo" }`,
+			errCheck: neverHappend,
+		},
+		{
+			name: "not an object",
+			source: `
+tags = var.tags`,
+			key:   "Env",
+			value: `"prod"`,
+			want:  "",
+			errCheck: func(err error) bool {
+				return err == nil || err.Error() != "object expression not found at main.tf:2,8-16"
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file, diags := hclsyntax.ParseConfig([]byte(test.source), "main.tf", hcl.InitialPos)
+			if diags.HasErrors() {
+				t.Fatalf("failed to parse HCL: %s", diags)
+			}
+			attrs, diags := file.Body.JustAttributes()
+			if diags.HasErrors() {
+				t.Fatal(diags)
+			}
+
+			fixer := NewFixer(map[string][]byte{"main.tf": []byte(test.source)})
+
+			err := fixer.SetObjectItem(attrs["tags"].Expr, test.key, test.value)
+			if test.errCheck(err) {
+				t.Fatalf("failed to check error: %s", err)
+			}
+
+			if diff := cmp.Diff(test.want, string(fixer.changes["main.tf"])); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
 func TestTextAt(t *testing.T) {
 	tests := []struct {
 		name string
@@ -1715,3 +2193,333 @@ func TestStashChanges(t *testing.T) {
 		})
 	}
 }
+
+func TestRunFixes(t *testing.T) {
+	insert := func(text string) func(tflint.Fixer) error {
+		return func(fixer tflint.Fixer) error {
+			return fixer.InsertTextAfter(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 0}, End: hcl.Pos{Byte: 7}}, text)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		source  string
+		fixes   []tflint.Fix
+		want    []FixResult
+		changes string
+		shifts  int
+	}{
+		{
+			name:   "alternatives",
+			source: `foo = 1`,
+			fixes: []tflint.Fix{
+				{Name: "bar", Func: insert("\nbar = 2")},
+				{Name: "baz", Func: insert("\nbaz = 3")},
+			},
+			want: []FixResult{
+				{
+					Name:    "bar",
+					Changes: map[string][]byte{"main.tf": []byte("foo = 1\nbar = 2")},
+					Edits:   []tflint.TextEdit{{Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 8, Byte: 7}, End: hcl.Pos{Line: 1, Column: 8, Byte: 7}}, NewText: "\nbar = 2"}},
+				},
+				{
+					Name:    "baz",
+					Changes: map[string][]byte{"main.tf": []byte("foo = 1\nbaz = 3")},
+					Edits:   []tflint.TextEdit{{Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 8, Byte: 7}, End: hcl.Pos{Line: 1, Column: 8, Byte: 7}}, NewText: "\nbaz = 3"}},
+				},
+			},
+			changes: "foo = 1\nbar = 2",
+			shifts:  1,
+		},
+		{
+			name:   "not supported",
+			source: `foo = 1`,
+			fixes: []tflint.Fix{
+				{Name: "bar", Func: func(fixer tflint.Fixer) error {
+					if err := insert("\nbar = 2")(fixer); err != nil {
+						return err
+					}
+					return tflint.ErrFixNotSupported
+				}},
+				{Name: "baz", Func: insert("\nbaz   =   3")},
+			},
+			want: []FixResult{
+				{
+					Name:    "baz",
+					Changes: map[string][]byte{"main.tf": []byte("foo = 1\nbaz = 3")},
+					Edits:   []tflint.TextEdit{{Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 8, Byte: 7}, End: hcl.Pos{Line: 1, Column: 8, Byte: 7}}, NewText: "\nbaz   =   3"}},
+				},
+			},
+			changes: "foo = 1\nbaz   =   3",
+			shifts:  1,
+		},
+		{
+			name:   "errors",
+			source: `foo = 1`,
+			fixes: []tflint.Fix{
+				{Name: "bar", Func: func(fixer tflint.Fixer) error { return errors.New("unexpected error") }},
+			},
+			want: []FixResult{
+				{Name: "bar", Changes: map[string][]byte{}, Edits: []tflint.TextEdit{}, Err: errors.New("unexpected error")},
+			},
+			changes: "",
+			shifts:  0,
+		},
+		{
+			name:    "no fixes",
+			source:  `foo = 1`,
+			fixes:   []tflint.Fix{},
+			want:    []FixResult{},
+			changes: "",
+			shifts:  0,
+		},
+	}
+
+	opt := cmp.Comparer(func(x, y error) bool {
+		return fmt.Sprint(x) == fmt.Sprint(y)
+	})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fixer := NewFixer(map[string][]byte{"main.tf": []byte(test.source)})
+
+			got := fixer.RunFixes(test.fixes)
+			if diff := cmp.Diff(test.want, got, opt); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(test.changes, string(fixer.changes["main.tf"])); diff != "" {
+				t.Error(diff)
+			}
+			if len(fixer.shifts) != test.shifts {
+				t.Errorf("shifts: want %d, got %d", test.shifts, len(fixer.shifts))
+			}
+		})
+	}
+}
+
+func TestEdits(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		fix    func(*Fixer) error
+		want   []tflint.TextEdit
+	}{
+		{
+			name:   "no changes",
+			source: `foo = 1`,
+			fix:    func(*Fixer) error { return nil },
+			want:   []tflint.TextEdit{},
+		},
+		{
+			name:   "replace and insert",
+			source: "foo = 1\nbar = 2\n",
+			fix: func(fixer *Fixer) error {
+				if err := fixer.ReplaceText(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 0}, End: hcl.Pos{Byte: 3}}, "foooo"); err != nil {
+					return err
+				}
+				return fixer.InsertTextAfter(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 8}, End: hcl.Pos{Byte: 15}}, "\nbaz = 3")
+			},
+			want: []tflint.TextEdit{
+				{
+					Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 1, Byte: 0}, End: hcl.Pos{Line: 1, Column: 4, Byte: 3}},
+					NewText: "foooo",
+				},
+				{
+					Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 2, Column: 8, Byte: 15}, End: hcl.Pos{Line: 2, Column: 8, Byte: 15}},
+					NewText: "\nbaz = 3",
+				},
+			},
+		},
+		{
+			name:   "replace the same range",
+			source: `foo = 1`,
+			fix: func(fixer *Fixer) error {
+				if err := fixer.ReplaceText(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 0}, End: hcl.Pos{Byte: 3}}, "foooo"); err != nil {
+					return err
+				}
+				return fixer.ReplaceText(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 0}, End: hcl.Pos{Byte: 3}}, "bar")
+			},
+			want: []tflint.TextEdit{
+				{
+					Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 1, Byte: 0}, End: hcl.Pos{Line: 1, Column: 4, Byte: 3}},
+					NewText: "bar",
+				},
+			},
+		},
+		{
+			name:   "minimized replacement",
+			source: `foo = (bar)`,
+			fix: func(fixer *Fixer) error {
+				return fixer.ReplaceText(
+					hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 6}, End: hcl.Pos{Byte: 11}},
+					"[",
+					fixer.TextAt(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 7}, End: hcl.Pos{Byte: 10}}),
+					"]",
+				)
+			},
+			want: []tflint.TextEdit{
+				{
+					Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 7, Byte: 6}, End: hcl.Pos{Line: 1, Column: 8, Byte: 7}},
+					NewText: "[",
+				},
+				{
+					Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 11, Byte: 10}, End: hcl.Pos{Line: 1, Column: 12, Byte: 11}},
+					NewText: "]",
+				},
+			},
+		},
+		{
+			name:   "rename references",
+			source: "variable \"foo\" {}\noutput \"foo\" {\n  value = var . foo\n}\n",
+			fix: func(fixer *Fixer) error {
+				return fixer.Rename("var.foo", "bar")
+			},
+			want: []tflint.TextEdit{
+				{
+					Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 10, Byte: 9}, End: hcl.Pos{Line: 1, Column: 15, Byte: 14}},
+					NewText: `"bar"`,
+				},
+				{
+					Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 3, Column: 17, Byte: 49}, End: hcl.Pos{Line: 3, Column: 20, Byte: 52}},
+					NewText: "bar",
+				},
+			},
+		},
+		{
+			name:   "multibyte characters",
+			source: "foo = \"こんにちは e\u0301\"\nbar = \"日本\" # 😀\n",
+			fix: func(fixer *Fixer) error {
+				if err := fixer.ReplaceText(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 6}, End: hcl.Pos{Byte: 27}}, `"hello"`); err != nil {
+					return err
+				}
+				if err := fixer.ReplaceText(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 34}, End: hcl.Pos{Byte: 42}}, `"japan"`); err != nil {
+					return err
+				}
+				return fixer.InsertTextAfter(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 45}, End: hcl.Pos{Byte: 49}}, " !")
+			},
+			want: []tflint.TextEdit{
+				{
+					// "e\u0301" is a single grapheme cluster
+					Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 7, Byte: 6}, End: hcl.Pos{Line: 1, Column: 16, Byte: 27}},
+					NewText: `"hello"`,
+				},
+				{
+					Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 2, Column: 7, Byte: 34}, End: hcl.Pos{Line: 2, Column: 11, Byte: 42}},
+					NewText: `"japan"`,
+				},
+				{
+					Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 2, Column: 15, Byte: 49}, End: hcl.Pos{Line: 2, Column: 15, Byte: 49}},
+					NewText: " !",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fixer := NewFixer(map[string][]byte{"main.tf": []byte(test.source)})
+			if err := test.fix(fixer); err != nil {
+				t.Fatalf("failed to fix: %s", err)
+			}
+
+			if diff := cmp.Diff(test.want, fixer.Edits()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestRunFixes_conflicts(t *testing.T) {
+	replace := func(start, end int, text string) func(tflint.Fixer) error {
+		return func(fixer tflint.Fixer) error {
+			return fixer.ReplaceText(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: start}, End: hcl.Pos{Byte: end}}, text)
+		}
+	}
+
+	fixer := NewFixer(map[string][]byte{"main.tf": []byte(`foo = "bar"`)})
+
+	// The first issue replaces "bar" with "baz"
+	first := fixer.RunFixes([]tflint.Fix{{Name: "first", Func: replace(6, 11, `"baz"`)}})
+	if first[0].Conflict != nil {
+		t.Fatalf("unexpected conflict: %s", first[0].Conflict)
+	}
+
+	// The second issue tries to rewrite the same value, but the alternative renames the key
+	second := fixer.RunFixes([]tflint.Fix{
+		{Name: "conflict", Func: replace(7, 10, "qux")},
+		{Name: "insert inside", Func: replace(8, 8, "qux")},
+		{Name: "rename", Func: replace(0, 3, "bar")},
+	})
+
+	wantEdit := tflint.TextEdit{
+		Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 7, Byte: 6}, End: hcl.Pos{Line: 1, Column: 12, Byte: 11}},
+		NewText: `"baz"`,
+	}
+	want := []FixResult{
+		{
+			Name:     "conflict",
+			Changes:  map[string][]byte{},
+			Edits:    []tflint.TextEdit{},
+			Conflict: &ConflictError{Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 7}, End: hcl.Pos{Byte: 10}}, Edit: wantEdit},
+		},
+		{
+			Name:     "insert inside",
+			Changes:  map[string][]byte{},
+			Edits:    []tflint.TextEdit{},
+			Conflict: &ConflictError{Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 8}, End: hcl.Pos{Byte: 8}}, Edit: wantEdit},
+		},
+		{
+			Name:    "rename",
+			Changes: map[string][]byte{"main.tf": []byte(`bar = "baz"`)},
+			Edits: []tflint.TextEdit{
+				{
+					Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 1, Byte: 0}, End: hcl.Pos{Line: 1, Column: 4, Byte: 3}},
+					NewText: "bar",
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, second); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(&second[2], DefaultFix(second)); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(`bar = "baz"`, string(fixer.changes["main.tf"])); diff != "" {
+		t.Error(diff)
+	}
+	if got := second[0].Conflict.Error(); got != "autofix conflicts with a previous fix: main.tf:1,7-12" {
+		t.Errorf("unexpected error message: %s", got)
+	}
+	if !errors.Is(second[0].Conflict, tflint.ErrFixConflict) {
+		t.Error("conflict should be ErrFixConflict")
+	}
+}
+
+func TestEditsOverlap(t *testing.T) {
+	rng := func(filename string, start, end int) hcl.Range {
+		return hcl.Range{Filename: filename, Start: hcl.Pos{Byte: start}, End: hcl.Pos{Byte: end}}
+	}
+
+	tests := []struct {
+		name string
+		a    hcl.Range
+		b    hcl.Range
+		want bool
+	}{
+		{name: "overlap", a: rng("main.tf", 0, 5), b: rng("main.tf", 3, 8), want: true},
+		{name: "adjacent", a: rng("main.tf", 0, 5), b: rng("main.tf", 5, 8), want: false},
+		{name: "different files", a: rng("main.tf", 0, 5), b: rng("sub.tf", 0, 5), want: false},
+		{name: "insertion inside", a: rng("main.tf", 0, 5), b: rng("main.tf", 3, 3), want: true},
+		{name: "insertion at the start", a: rng("main.tf", 3, 3), b: rng("main.tf", 3, 5), want: false},
+		{name: "insertions at the same position", a: rng("main.tf", 3, 3), b: rng("main.tf", 3, 3), want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := editsOverlap(test.a, test.b); got != test.want {
+				t.Errorf("want %t, got %t", test.want, got)
+			}
+		})
+	}
+}
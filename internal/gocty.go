@@ -0,0 +1,195 @@
+package internal
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/gocty"
+)
+
+var (
+	valueType    = reflect.TypeOf(cty.Value{})
+	bigFloatType = reflect.TypeOf(big.Float{})
+	bigIntType   = reflect.TypeOf(big.Int{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// ImpliedType returns a cty.Type inferred from the Go type of the passed target.
+// The target must be a pointer.
+//
+// Unlike gocty.ImpliedType, it also supports big.Float, big.Int, and time.Duration.
+// time.Duration is represented as a string like "5m", and decoded by FromCtyValue.
+// Struct fields of pointer types are treated as optional attributes so that
+// they can be omitted or null.
+func ImpliedType(target any) (cty.Type, error) {
+	rt := reflect.TypeOf(target)
+	if rt == nil || rt.Kind() != reflect.Pointer {
+		return cty.NilType, fmt.Errorf("target must be a pointer, but got %T", target)
+	}
+	return impliedType(rt.Elem(), cty.Path{})
+}
+
+func impliedType(rt reflect.Type, path cty.Path) (cty.Type, error) {
+	switch rt {
+	case valueType:
+		return cty.DynamicPseudoType, nil
+	case durationType:
+		return cty.String, nil
+	case bigFloatType, bigIntType:
+		return cty.Number, nil
+	}
+
+	switch rt.Kind() {
+	case reflect.Pointer:
+		return impliedType(rt.Elem(), path)
+
+	case reflect.Bool:
+		return cty.Bool, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return cty.Number, nil
+	case reflect.String:
+		return cty.String, nil
+
+	case reflect.Slice:
+		ety, err := impliedType(rt.Elem(), path.Index(cty.UnknownVal(cty.Number)))
+		if err != nil {
+			return cty.NilType, err
+		}
+		return cty.List(ety), nil
+	case reflect.Map:
+		if rt.Key().Kind() != reflect.String {
+			return cty.NilType, path.NewErrorf("no cty.Type for %s (must have string keys)", rt)
+		}
+		ety, err := impliedType(rt.Elem(), path.Index(cty.UnknownVal(cty.String)))
+		if err != nil {
+			return cty.NilType, err
+		}
+		return cty.Map(ety), nil
+
+	case reflect.Struct:
+		fields := structTagIndices(rt)
+		if len(fields) == 0 {
+			return cty.NilType, path.NewErrorf("no cty.Type for %s (no cty field tags)", rt)
+		}
+
+		atys := map[string]cty.Type{}
+		optionals := []string{}
+		for name, idx := range fields {
+			ft := rt.Field(idx).Type
+			aty, err := impliedType(ft, path.GetAttr(name))
+			if err != nil {
+				return cty.NilType, err
+			}
+			atys[name] = aty
+
+			if ft.Kind() == reflect.Pointer {
+				optionals = append(optionals, name)
+			}
+		}
+		if len(optionals) == 0 {
+			return cty.Object(atys), nil
+		}
+		return cty.ObjectWithOptionalAttrs(atys, optionals), nil
+
+	default:
+		return cty.NilType, path.NewErrorf("no cty.Type for %s", rt)
+	}
+}
+
+// IsNullable returns true if a null value at the given path can be assigned to the target.
+// It is only allowed if the Go type at the path is a pointer or cty.Value.
+func IsNullable(target any, path cty.Path) bool {
+	rt, exists := typeAtPath(reflect.TypeOf(target).Elem(), path)
+	if !exists {
+		return false
+	}
+	return rt.Kind() == reflect.Pointer || rt == valueType
+}
+
+// FromCtyValue assigns a cty.Value to the target like gocty.FromCtyValue,
+// but it also decodes strings into time.Duration by time.ParseDuration.
+func FromCtyValue(val cty.Value, target any) error {
+	rt := reflect.TypeOf(target).Elem()
+
+	val, err := cty.Transform(val, func(path cty.Path, v cty.Value) (cty.Value, error) {
+		if !v.Type().Equals(cty.String) {
+			return v, nil
+		}
+		if ty, exists := typeAtPath(rt, path); !exists || indirect(ty) != durationType {
+			return v, nil
+		}
+
+		// Durations are decoded as numbers, so the types of null and unknown values are also changed
+		// to keep collection elements consistent.
+		if v.IsNull() {
+			return cty.NullVal(cty.Number), nil
+		}
+		if !v.IsKnown() {
+			return cty.UnknownVal(cty.Number), nil
+		}
+
+		d, err := time.ParseDuration(v.AsString())
+		if err != nil {
+			return cty.NilVal, path.NewErrorf("invalid duration: %s", err)
+		}
+		return cty.NumberIntVal(int64(d)), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return gocty.FromCtyValue(val, target)
+}
+
+// typeAtPath returns the Go type corresponding to the path in the passed type.
+func typeAtPath(rt reflect.Type, path cty.Path) (reflect.Type, bool) {
+	for _, step := range path {
+		rt = indirect(rt)
+
+		switch step := step.(type) {
+		case cty.GetAttrStep:
+			if rt.Kind() != reflect.Struct {
+				return nil, false
+			}
+			idx, exists := structTagIndices(rt)[step.Name]
+			if !exists {
+				return nil, false
+			}
+			rt = rt.Field(idx).Type
+		case cty.IndexStep:
+			switch rt.Kind() {
+			case reflect.Slice, reflect.Array, reflect.Map:
+				rt = rt.Elem()
+			default:
+				return nil, false
+			}
+		default:
+			return nil, false
+		}
+	}
+	return rt, true
+}
+
+func indirect(rt reflect.Type) reflect.Type {
+	for rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+	return rt
+}
+
+// structTagIndices returns a map from cty attribute names to field indices
+// in the same way as gocty.
+func structTagIndices(rt reflect.Type) map[string]int {
+	ret := map[string]int{}
+	for i := 0; i < rt.NumField(); i++ {
+		if name := rt.Field(i).Tag.Get("cty"); name != "" {
+			ret[name] = i
+		}
+	}
+	return ret
+}
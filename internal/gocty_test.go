@@ -0,0 +1,301 @@
+package internal
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestImpliedType(t *testing.T) {
+	type object struct {
+		Name    string `cty:"name"`
+		Enabled *bool  `cty:"enabled"`
+		ignored string
+	}
+	type nested struct {
+		Objects  []object          `cty:"objects"`
+		Timeout  time.Duration     `cty:"timeout"`
+		Labels   map[string]string `cty:"labels"`
+		Override *object           `cty:"override"`
+	}
+
+	tests := []struct {
+		name   string
+		target any
+		want   cty.Type
+		err    string
+	}{
+		{
+			name:   "string",
+			target: new(string),
+			want:   cty.String,
+		},
+		{
+			name:   "int64",
+			target: new(int64),
+			want:   cty.Number,
+		},
+		{
+			name:   "float64",
+			target: new(float64),
+			want:   cty.Number,
+		},
+		{
+			name:   "big.Float",
+			target: new(big.Float),
+			want:   cty.Number,
+		},
+		{
+			name:   "pointer to pointer",
+			target: new(*string),
+			want:   cty.String,
+		},
+		{
+			name:   "time.Duration",
+			target: new(time.Duration),
+			want:   cty.String,
+		},
+		{
+			name:   "nested slices and maps",
+			target: new(map[string][][]int),
+			want:   cty.Map(cty.List(cty.List(cty.Number))),
+		},
+		{
+			name:   "cty.Value",
+			target: new([]cty.Value),
+			want:   cty.List(cty.DynamicPseudoType),
+		},
+		{
+			name:   "struct",
+			target: new(object),
+			want: cty.ObjectWithOptionalAttrs(map[string]cty.Type{
+				"name":    cty.String,
+				"enabled": cty.Bool,
+			}, []string{"enabled"}),
+		},
+		{
+			name:   "nested struct",
+			target: new(nested),
+			want: cty.ObjectWithOptionalAttrs(map[string]cty.Type{
+				"objects": cty.List(cty.ObjectWithOptionalAttrs(map[string]cty.Type{
+					"name":    cty.String,
+					"enabled": cty.Bool,
+				}, []string{"enabled"})),
+				"timeout": cty.String,
+				"labels":  cty.Map(cty.String),
+				"override": cty.ObjectWithOptionalAttrs(map[string]cty.Type{
+					"name":    cty.String,
+					"enabled": cty.Bool,
+				}, []string{"enabled"}),
+			}, []string{"override"}),
+		},
+		{
+			name:   "not a pointer",
+			target: "foo",
+			err:    "target must be a pointer, but got string",
+		},
+		{
+			name:   "struct without cty tags",
+			target: new(struct{ Name string }),
+			err:    "no cty.Type for struct { Name string } (no cty field tags)",
+		},
+		{
+			name:   "map with int keys",
+			target: new(map[int]string),
+			err:    "no cty.Type for map[int]string (must have string keys)",
+		},
+		{
+			name: "unsupported type in struct",
+			target: new(struct {
+				Ch chan int `cty:"ch"`
+			}),
+			err: "no cty.Type for chan int",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ImpliedType(test.target)
+			if err != nil {
+				if test.err == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if err.Error() != test.err {
+					t.Fatalf(`expected "%s", but got "%s"`, test.err, err)
+				}
+				return
+			}
+			if test.err != "" {
+				t.Fatalf("expected an error, but got nothing")
+			}
+
+			if !got.Equals(test.want) {
+				t.Errorf("expected %s, but got %s", test.want.GoString(), got.GoString())
+			}
+		})
+	}
+}
+
+func TestIsNullable(t *testing.T) {
+	type object struct {
+		Name    string         `cty:"name"`
+		Enabled *bool          `cty:"enabled"`
+		Tags    map[string]any `cty:"tags"`
+		Value   cty.Value      `cty:"value"`
+	}
+
+	tests := []struct {
+		name   string
+		target any
+		path   cty.Path
+		want   bool
+	}{
+		{
+			name:   "string",
+			target: new(string),
+			path:   cty.Path{},
+			want:   false,
+		},
+		{
+			name:   "pointer to pointer",
+			target: new(*string),
+			path:   cty.Path{},
+			want:   true,
+		},
+		{
+			name:   "non-pointer field",
+			target: new([]object),
+			path:   cty.IndexIntPath(0).GetAttr("name"),
+			want:   false,
+		},
+		{
+			name:   "pointer field",
+			target: new([]object),
+			path:   cty.IndexIntPath(0).GetAttr("enabled"),
+			want:   true,
+		},
+		{
+			name:   "cty.Value field",
+			target: new(object),
+			path:   cty.GetAttrPath("value"),
+			want:   true,
+		},
+		{
+			name:   "unknown field",
+			target: new(object),
+			path:   cty.GetAttrPath("unknown"),
+			want:   false,
+		},
+		{
+			name:   "pointer to slice",
+			target: new([]*string),
+			path:   cty.IndexIntPath(1),
+			want:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := IsNullable(test.target, test.path)
+			if got != test.want {
+				t.Errorf("expected %t, but got %t", test.want, got)
+			}
+		})
+	}
+}
+
+func TestFromCtyValue(t *testing.T) {
+	type object struct {
+		Name    string         `cty:"name"`
+		Timeout *time.Duration `cty:"timeout"`
+	}
+
+	tests := []struct {
+		name   string
+		val    cty.Value
+		target func() any
+		want   any
+		err    string
+	}{
+		{
+			name:   "string",
+			val:    cty.StringVal("foo"),
+			target: func() any { return new(string) },
+			want:   "foo",
+		},
+		{
+			name:   "time.Duration",
+			val:    cty.StringVal("5m"),
+			target: func() any { return new(time.Duration) },
+			want:   5 * time.Minute,
+		},
+		{
+			name: "time.Duration in struct",
+			val: cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"name":    cty.StringVal("foo"),
+					"timeout": cty.StringVal("1h30m"),
+				}),
+				cty.ObjectVal(map[string]cty.Value{
+					"name":    cty.StringVal("bar"),
+					"timeout": cty.NullVal(cty.String),
+				}),
+			}),
+			target: func() any { return new([]object) },
+			want: []object{
+				{Name: "foo", Timeout: func() *time.Duration { d := 90 * time.Minute; return &d }()},
+				{Name: "bar"},
+			},
+		},
+		{
+			name:   "durations in map",
+			val:    cty.MapVal(map[string]cty.Value{"foo": cty.StringVal("1s"), "bar": cty.StringVal("2ms")}),
+			target: func() any { return new(map[string]time.Duration) },
+			want:   map[string]time.Duration{"foo": time.Second, "bar": 2 * time.Millisecond},
+		},
+		{
+			name:   "invalid duration",
+			val:    cty.ListVal([]cty.Value{cty.StringVal("1s"), cty.StringVal("foo")}),
+			target: func() any { return new([]time.Duration) },
+			err:    `invalid duration: time: invalid duration "foo"`,
+		},
+		{
+			name:   "big.Float",
+			val:    cty.NumberFloatVal(1.5),
+			target: func() any { return new(big.Float) },
+			want:   *big.NewFloat(1.5),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			target := test.target()
+
+			err := FromCtyValue(test.val, target)
+			if err != nil {
+				if test.err == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if err.Error() != test.err {
+					t.Fatalf(`expected "%s", but got "%s"`, test.err, err)
+				}
+				return
+			}
+			if test.err != "" {
+				t.Fatalf("expected an error, but got nothing")
+			}
+
+			opts := cmp.Options{
+				cmp.Comparer(func(x, y big.Float) bool { return x.Cmp(&y) == 0 }),
+			}
+			got := reflect.ValueOf(target).Elem().Interface()
+			if diff := cmp.Diff(test.want, got, opts); diff != "" {
+				t.Errorf("diff: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package internal
+
+import (
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/terraform/addrs"
+	"github.com/terraform-linters/tflint-plugin-sdk/terraform/lang"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+var traceFileSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{
+		{Type: "locals"},
+		{Type: "variable", LabelNames: []string{"name"}},
+	},
+}
+
+var traceVariableSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{{Name: "default"}},
+}
+
+type valueDecl struct {
+	expr      hcl.Expression
+	declRange hcl.Range
+}
+
+// TraceValue traces the value of the given expression back through local values
+// and input variables declared in the given files.
+// Values passed from outside of the module, such as tfvars and module arguments,
+// are not traced. The trace stops at the `default` of the variable.
+// Note this API is not intended to be used by plugins.
+func TraceValue(files map[string]*hcl.File, expr hcl.Expression) (*tflint.ValueSource, hcl.Diagnostics) {
+	decls := map[string]*valueDecl{}
+	var diags hcl.Diagnostics
+
+	filenames := make([]string, 0, len(files))
+	for name := range files {
+		filenames = append(filenames, name)
+	}
+	sort.Strings(filenames)
+
+	for _, name := range filenames {
+		content, _, contentDiags := files[name].Body.PartialContent(traceFileSchema)
+		diags = diags.Extend(contentDiags)
+		if contentDiags.HasErrors() {
+			continue
+		}
+
+		for _, block := range content.Blocks {
+			switch block.Type {
+			case "locals":
+				attrs, attrDiags := block.Body.JustAttributes()
+				diags = diags.Extend(attrDiags)
+				for _, attr := range attrs {
+					addr := addrs.LocalValue{Name: attr.Name}.String()
+					if _, exists := decls[addr]; !exists {
+						decls[addr] = &valueDecl{expr: attr.Expr, declRange: attr.Range}
+					}
+				}
+			case "variable":
+				addr := addrs.InputVariable{Name: block.Labels[0]}.String()
+				if _, exists := decls[addr]; exists {
+					continue
+				}
+				variable, _, varDiags := block.Body.PartialContent(traceVariableSchema)
+				diags = diags.Extend(varDiags)
+
+				decl := &valueDecl{declRange: block.DefRange}
+				if attr, exists := variable.Attributes["default"]; exists {
+					decl.expr = attr.Expr
+				}
+				decls[addr] = decl
+			}
+		}
+	}
+
+	root := &tflint.ValueSource{Expr: expr}
+	root.Sources = traceSources(expr, decls, map[string]bool{})
+	return root, diags
+}
+
+func traceSources(expr hcl.Expression, decls map[string]*valueDecl, visiting map[string]bool) []*tflint.ValueSource {
+	if expr == nil {
+		return nil
+	}
+
+	var sources []*tflint.ValueSource
+	for _, ref := range lang.ReferencesInExpr(expr) {
+		var addr string
+		switch subject := ref.Subject.(type) {
+		case addrs.LocalValue:
+			addr = subject.String()
+		case addrs.InputVariable:
+			addr = subject.String()
+		default:
+			continue
+		}
+
+		decl, exists := decls[addr]
+		if !exists {
+			continue
+		}
+		source := &tflint.ValueSource{Ref: ref, Expr: decl.expr, DeclRange: decl.declRange}
+
+		// Stop tracing if the reference is cyclic.
+		if !visiting[addr] {
+			visiting[addr] = true
+			source.Sources = traceSources(decl.expr, decls, visiting)
+			delete(visiting, addr)
+		}
+		sources = append(sources, source)
+	}
+
+	sort.SliceStable(sources, func(i, j int) bool {
+		return sources[i].Ref.SourceRange.Start.Byte < sources[j].Ref.SourceRange.Start.Byte
+	})
+	return sources
+}
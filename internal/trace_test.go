@@ -0,0 +1,221 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// dumpValueSource returns a tree of sources in a readable format like:
+//
+//	local.foo = "${var.bar}"
+//	  var.bar = "baz"
+func dumpValueSource(source *tflint.ValueSource, sources map[string][]byte) []string {
+	ret := []string{}
+	var dump func(*tflint.ValueSource, int)
+	dump = func(s *tflint.ValueSource, depth int) {
+		for _, child := range s.Sources {
+			expr := "<nil>"
+			if child.Expr != nil {
+				expr = string(child.Expr.Range().SliceBytes(sources[child.Expr.Range().Filename]))
+			}
+			ret = append(ret, fmt.Sprintf("%s%s = %s", strings.Repeat("  ", depth), child.Ref.Subject, expr))
+			dump(child, depth+1)
+		}
+	}
+	dump(source, 0)
+	return ret
+}
+
+func TestTraceValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		sources map[string]string
+		expr    func(map[string]*hcl.File) hcl.Expression
+		want    []string
+		origins []string
+	}{
+		{
+			name: "literal",
+			sources: map[string]string{
+				"main.tf": `
+resource "aws_instance" "main" {
+  instance_type = "t2.micro"
+}`,
+			},
+			want:    []string{},
+			origins: []string{`"t2.micro"`},
+		},
+		{
+			name: "locals and variables",
+			sources: map[string]string{
+				"main.tf": `
+resource "aws_instance" "main" {
+  instance_type = local.instance_type
+}`,
+				"locals.tf": `
+locals {
+  instance_type = "${local.family}.${var.size}"
+  family        = "t2"
+}`,
+				"variables.tf": `
+variable "size" {
+  default = "micro"
+}`,
+			},
+			want: []string{
+				`local.instance_type = "${local.family}.${var.size}"`,
+				`  local.family = "t2"`,
+				`  var.size = "micro"`,
+			},
+			origins: []string{`"t2"`, `"micro"`},
+		},
+		{
+			name: "variable without default",
+			sources: map[string]string{
+				"main.tf": `
+variable "size" {}
+
+resource "aws_instance" "main" {
+  instance_type = var.size
+}`,
+			},
+			want:    []string{"var.size = <nil>"},
+			origins: []string{"<nil>"},
+		},
+		{
+			name: "resource references are origins",
+			sources: map[string]string{
+				"main.tf": `
+locals {
+  ami = data.aws_ami.main.id
+}
+
+resource "aws_instance" "main" {
+  instance_type = local.ami
+}`,
+			},
+			want:    []string{"local.ami = data.aws_ami.main.id"},
+			origins: []string{"data.aws_ami.main.id"},
+		},
+		{
+			name: "cyclic references",
+			sources: map[string]string{
+				"main.tf": `
+locals {
+  foo = local.bar
+  bar = local.foo
+}
+
+resource "aws_instance" "main" {
+  instance_type = local.foo
+}`,
+			},
+			want: []string{
+				"local.foo = local.bar",
+				"  local.bar = local.foo",
+				"    local.foo = local.bar",
+			},
+			origins: []string{"local.bar"},
+		},
+		{
+			name: "JSON syntax",
+			sources: map[string]string{
+				"main.tf.json": `{
+  "locals": {
+    "instance_type": "${var.size}"
+  },
+  "variable": {
+    "size": {
+      "default": "t2.micro"
+    }
+  },
+  "resource": {
+    "aws_instance": {
+      "main": {
+        "instance_type": "${local.instance_type}"
+      }
+    }
+  }
+}`,
+			},
+			expr: func(files map[string]*hcl.File) hcl.Expression {
+				content, _, _ := files["main.tf.json"].Body.PartialContent(&hcl.BodySchema{
+					Blocks: []hcl.BlockHeaderSchema{{Type: "resource", LabelNames: []string{"type", "name"}}},
+				})
+				resourceAttrs, _ := content.Blocks[0].Body.JustAttributes()
+				return resourceAttrs["instance_type"].Expr
+			},
+			want: []string{
+				`local.instance_type = "${var.size}"`,
+				`  var.size = "t2.micro"`,
+			},
+			origins: []string{`"t2.micro"`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parser := hclparse.NewParser()
+			files := map[string]*hcl.File{}
+			sources := map[string][]byte{}
+			for name, src := range test.sources {
+				var file *hcl.File
+				var diags hcl.Diagnostics
+				if strings.HasSuffix(name, ".json") {
+					file, diags = parser.ParseJSON([]byte(src), name)
+				} else {
+					file, diags = parser.ParseHCL([]byte(src), name)
+				}
+				if diags.HasErrors() {
+					t.Fatal(diags)
+				}
+				files[name] = file
+				sources[name] = []byte(src)
+			}
+
+			var expr hcl.Expression
+			if test.expr != nil {
+				expr = test.expr(files)
+			} else {
+				content, _, diags := files["main.tf"].Body.PartialContent(&hcl.BodySchema{
+					Blocks: []hcl.BlockHeaderSchema{{Type: "resource", LabelNames: []string{"type", "name"}}},
+				})
+				if diags.HasErrors() {
+					t.Fatal(diags)
+				}
+				attrs, diags := content.Blocks[0].Body.JustAttributes()
+				if diags.HasErrors() {
+					t.Fatal(diags)
+				}
+				expr = attrs["instance_type"].Expr
+			}
+
+			source, diags := TraceValue(files, expr)
+			if diags.HasErrors() {
+				t.Fatal(diags)
+			}
+
+			if diff := cmp.Diff(test.want, dumpValueSource(source, sources)); diff != "" {
+				t.Errorf("sources: %s", diff)
+			}
+
+			origins := []string{}
+			for _, origin := range source.Origins() {
+				if origin.Expr == nil {
+					origins = append(origins, "<nil>")
+					continue
+				}
+				origins = append(origins, string(origin.Expr.Range().SliceBytes(sources[origin.Expr.Range().Filename])))
+			}
+			if diff := cmp.Diff(test.origins, origins); diff != "" {
+				t.Errorf("origins: %s", diff)
+			}
+		})
+	}
+}
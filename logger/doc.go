@@ -4,4 +4,12 @@
 // You can freely write logs from anywhere via the public API according to the log level.
 // The log by hclog is interpreted as a structured log by go-plugin, and the log level
 // can be handled correctly.
+//
+// The log level is set by the TFLINT_LOG environment variable, and TFLint can also
+// change it via the plugin config. If TFLINT_LOG_FORMAT is "json", logs are written
+// in JSON format. In this format, go-plugin forwards logs to TFLint with their levels
+// and key-value pairs preserved.
+//
+// Within rules, prefer tflint.Runner.Logger, which returns a logger tagged with
+// the rule name, module path, and check ID.
 package logger
@@ -1,7 +1,10 @@
 package logger
 
 import (
+	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/hashicorp/go-hclog"
 )
@@ -24,17 +27,23 @@ func init() {
 		level = "off"
 	}
 
+	setup(hclog.LevelFromString(level), strings.EqualFold(os.Getenv("TFLINT_LOG_FORMAT"), "json"), os.Stderr)
+}
+
+func setup(level hclog.Level, json bool, output io.Writer) {
 	internalLogger = hclog.New(&hclog.LoggerOptions{
-		Level:                    hclog.LevelFromString(level),
-		Output:                   os.Stderr,
+		Level:                    level,
+		Output:                   output,
 		TimeFormat:               "15:04:05",
+		JSONFormat:               json,
 		IncludeLocation:          true,
 		AdditionalLocationOffset: 1,
 	})
 	logger = hclog.New(&hclog.LoggerOptions{
-		Level:           hclog.LevelFromString(level),
-		Output:          os.Stderr,
+		Level:           level,
+		Output:          output,
 		TimeFormat:      "15:04:05",
+		JSONFormat:      json,
 		IncludeLocation: true,
 	})
 }
@@ -44,6 +53,19 @@ func Logger() hclog.Logger {
 	return logger
 }
 
+// SetLevel changes the log level of the global logger, including loggers derived from it.
+// The level is one of "trace", "debug", "info", "warn", "error", or "off".
+func SetLevel(level string) error {
+	lvl := hclog.LevelFromString(level)
+	if lvl == hclog.NoLevel {
+		return fmt.Errorf(`invalid log level "%s"`, level)
+	}
+
+	internalLogger.SetLevel(lvl)
+	logger.SetLevel(lvl)
+	return nil
+}
+
 // Trace emits a message at the TRACE level
 func Trace(msg string, args ...interface{}) {
 	if internalLogger == nil {
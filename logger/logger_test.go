@@ -0,0 +1,53 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestSetLevel(t *testing.T) {
+	var buf bytes.Buffer
+	setup(hclog.Off, true, &buf)
+	defer setup(hclog.Off, false, os.Stderr)
+
+	ruleLogger := Logger().With("rule", "aws_instance_invalid_type")
+	ruleLogger.Debug("not emitted")
+	if buf.Len() != 0 {
+		t.Fatalf("unexpected log: %s", buf.String())
+	}
+
+	if err := SetLevel("debug"); err != nil {
+		t.Fatal(err)
+	}
+	// The level of the derived logger also changes.
+	ruleLogger.Debug("emitted", "module", "root")
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("failed to parse a JSON log: %s", err)
+	}
+	delete(got, "@timestamp")
+	delete(got, "@caller")
+
+	want := map[string]interface{}{
+		"@level":   "debug",
+		"@message": "emitted",
+		"rule":     "aws_instance_invalid_type",
+		"module":   "root",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestSetLevel_invalid(t *testing.T) {
+	err := SetLevel("verbose")
+	if err == nil || err.Error() != `invalid log level "verbose"` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -7,52 +7,74 @@ import (
 	"github.com/hashicorp/hcl/v2"
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/plugin/internal/proto"
+	"github.com/terraform-linters/tflint-plugin-sdk/terraform/addrs"
 	"github.com/terraform-linters/tflint-plugin-sdk/terraform/lang/marks"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/json"
 	"github.com/zclconf/go-cty/cty/msgpack"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 // BodySchema converts proto.BodySchema to hclext.BodySchema
-func BodySchema(body *proto.BodySchema) *hclext.BodySchema {
+func BodySchema(body *proto.BodySchema) (*hclext.BodySchema, error) {
 	if body == nil {
-		return nil
+		return nil, nil
 	}
 
 	attributes := make([]hclext.AttributeSchema, len(body.Attributes))
 	for idx, attr := range body.Attributes {
-		attributes[idx] = hclext.AttributeSchema{Name: attr.Name, Required: attr.Required}
+		attributes[idx] = hclext.AttributeSchema{Name: attr.Name, Required: attr.Required, LiteralOnly: attr.LiteralOnly}
+		if len(attr.Type) > 0 {
+			ty, err := json.UnmarshalType(attr.Type)
+			if err != nil {
+				return nil, fmt.Errorf("invalid type of %s attribute: %w", attr.Name, err)
+			}
+			attributes[idx].Type = ty
+		}
 	}
 
 	blocks := make([]hclext.BlockSchema, len(body.Blocks))
 	for idx, block := range body.Blocks {
+		child, err := BodySchema(block.Body)
+		if err != nil {
+			return nil, err
+		}
 		blocks[idx] = hclext.BlockSchema{
 			Type:       block.Type,
 			LabelNames: block.LabelNames,
-			Body:       BodySchema(block.Body),
+			Body:       child,
 		}
 	}
 
+	mode, err := SchemaMode(body.Mode)
+	if err != nil {
+		return nil, err
+	}
+
 	return &hclext.BodySchema{
-		Mode:       SchemaMode(body.Mode),
+		Mode:       mode,
 		Attributes: attributes,
 		Blocks:     blocks,
-	}
+		Depth:      int(body.Depth),
+	}, nil
 }
 
 // SchemaMode converts proto.SchemaMode to hclext.SchemaMode
-func SchemaMode(mode proto.SchemaMode) hclext.SchemaMode {
+// Unknown modes sent from newer SDKs return an error.
+func SchemaMode(mode proto.SchemaMode) (hclext.SchemaMode, error) {
 	switch mode {
 	case proto.SchemaMode_SCHEMA_MODE_UNSPECIFIED:
-		return hclext.SchemaDefaultMode
+		return hclext.SchemaDefaultMode, nil
 	case proto.SchemaMode_SCHEMA_MODE_DEFAULT:
-		return hclext.SchemaDefaultMode
+		return hclext.SchemaDefaultMode, nil
 	case proto.SchemaMode_SCHEMA_MODE_JUST_ATTRIBUTES:
-		return hclext.SchemaJustAttributesMode
+		return hclext.SchemaJustAttributesMode, nil
+	case proto.SchemaMode_SCHEMA_MODE_RECURSIVE:
+		return hclext.SchemaRecursiveMode, nil
 	default:
-		panic(fmt.Sprintf("invalid SchemaMode: %s", mode))
+		return hclext.SchemaDefaultMode, fmt.Errorf("unknown schema mode: %s", mode)
 	}
 }
 
@@ -86,6 +108,16 @@ func BodyContent(body *proto.BodyContent) (*hclext.BodyContent, hcl.Diagnostics)
 			labelRanges[idx] = Range(labelRange)
 		}
 
+		instance, err := BlockInstance(block.Instance)
+		if err != nil {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "failed to decode the block instance",
+				Detail:   err.Error(),
+				Subject:  Range(block.DefRange).Ptr(),
+			})
+		}
+
 		blocks[idx] = &hclext.Block{
 			Type:        block.Type,
 			Labels:      block.Labels,
@@ -93,6 +125,7 @@ func BodyContent(body *proto.BodyContent) (*hclext.BodyContent, hcl.Diagnostics)
 			DefRange:    Range(block.DefRange),
 			TypeRange:   Range(block.TypeRange),
 			LabelRanges: labelRanges,
+			Instance:    instance,
 		}
 	}
 
@@ -102,6 +135,29 @@ func BodyContent(body *proto.BodyContent) (*hclext.BodyContent, hcl.Diagnostics)
 	}, diags
 }
 
+// BlockInstance converts proto.BodyContent_Block_Instance to hclext.BlockInstance
+func BlockInstance(instance *proto.BodyContent_Block_Instance) (*hclext.BlockInstance, error) {
+	if instance == nil {
+		return nil, nil
+	}
+
+	out := &hclext.BlockInstance{Key: addrs.NoKey, Dynamic: instance.Dynamic}
+	switch key := instance.Key.(type) {
+	case *proto.BodyContent_Block_Instance_IntKey:
+		out.Key = addrs.IntKey(key.IntKey)
+	case *proto.BodyContent_Block_Instance_StringKey:
+		out.Key = addrs.StringKey(key.StringKey)
+	}
+	if instance.Value != nil {
+		val, err := Value(instance.Value, cty.DynamicPseudoType, instance.ValueMarks)
+		if err != nil {
+			return nil, err
+		}
+		out.Value = val
+	}
+	return out, nil
+}
+
 // RuleObject is an intermediate representation that satisfies the Rule interface.
 type RuleObject struct {
 	tflint.DefaultRule
@@ -224,6 +280,15 @@ func Pos(pos *proto.Range_Pos) hcl.Pos {
 	}
 }
 
+// TextEdits converts []*proto.TextEdit to []tflint.TextEdit
+func TextEdits(edits []*proto.TextEdit) []tflint.TextEdit {
+	ret := make([]tflint.TextEdit, len(edits))
+	for i, edit := range edits {
+		ret[i] = tflint.TextEdit{Range: Range(edit.Range), NewText: edit.NewText}
+	}
+	return ret
+}
+
 // Value converts msgpack and []proto.ValueMark to cty.Value
 func Value(value []byte, ty cty.Type, valueMarks []*proto.ValueMark) (cty.Value, error) {
 	val, err := msgpack.Unmarshal(value, ty)
@@ -281,6 +346,24 @@ func Config(config *proto.ApplyGlobalConfig_Config) *tflint.Config {
 		DisabledByDefault: config.DisabledByDefault,
 		Only:              config.Only,
 		Fix:               config.Fix,
+		FormatMode:        FormatMode(config.FormatMode),
+		LogLevel:          config.LogLevel,
+	}
+}
+
+// FormatMode converts proto.ApplyGlobalConfig_FormatMode to tflint.FormatMode
+func FormatMode(mode proto.ApplyGlobalConfig_FormatMode) tflint.FormatMode {
+	switch mode {
+	case proto.ApplyGlobalConfig_FORMAT_MODE_UNSPECIFIED:
+		return tflint.FormatModeFile
+	case proto.ApplyGlobalConfig_FORMAT_MODE_FILE:
+		return tflint.FormatModeFile
+	case proto.ApplyGlobalConfig_FORMAT_MODE_CHANGED_BLOCKS:
+		return tflint.FormatModeChangedBlocks
+	case proto.ApplyGlobalConfig_FORMAT_MODE_NONE:
+		return tflint.FormatModeNone
+	default:
+		panic(fmt.Sprintf("invalid FormatMode: %s", mode))
 	}
 }
 
@@ -369,3 +452,16 @@ func Error(err error) error {
 
 	return err
 }
+
+// ErrorDetail converts proto.ErrorDetail to wrapped error
+func ErrorDetail(detail *proto.ErrorDetail) error {
+	if detail == nil {
+		return nil
+	}
+
+	switch detail.Code {
+	case proto.ErrorCode_ERROR_CODE_SENSITIVE:
+		return tflint.ErrSensitive
+	}
+	return errors.New(detail.Message)
+}
@@ -85,3 +85,14 @@ func TestExpression(t *testing.T) {
 		})
 	}
 }
+
+func TestBodySchema_unknownMode(t *testing.T) {
+	_, err := BodySchema(&proto.BodySchema{
+		Blocks: []*proto.BodySchema_Block{
+			{Type: "resource", Body: &proto.BodySchema{Mode: proto.SchemaMode(100)}},
+		},
+	})
+	if err == nil || err.Error() != "unknown schema mode: 100" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
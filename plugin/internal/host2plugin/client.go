@@ -2,6 +2,8 @@ package host2plugin
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"os/exec"
 
 	"github.com/hashicorp/go-plugin"
@@ -14,6 +16,7 @@ import (
 	"github.com/terraform-linters/tflint-plugin-sdk/plugin/internal/proto"
 	"github.com/terraform-linters/tflint-plugin-sdk/plugin/internal/toproto"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-plugin-sdk/tracing"
 	"google.golang.org/grpc"
 )
 
@@ -96,7 +99,11 @@ func (c *GRPCClient) ConfigSchema() (*hclext.BodySchema, error) {
 	if err != nil {
 		return nil, fromproto.Error(err)
 	}
-	return fromproto.BodySchema(resp.Schema), nil
+	schema, err := fromproto.BodySchema(resp.Schema)
+	if err != nil {
+		return nil, err
+	}
+	return schema, nil
 }
 
 // ApplyGlobalConfig applies a common config to a plugin.
@@ -120,19 +127,41 @@ func (c *GRPCClient) ApplyConfig(content *hclext.BodyContent, sources map[string
 // Check calls its own plugin implementation with an gRPC client that can send
 // requests to the host process.
 func (c *GRPCClient) Check(runner plugin2host.Server) error {
+	return c.CheckWithContext(context.Background(), runner)
+}
+
+// CheckWithContext is the same as Check, but the span of the check is recorded
+// as a child of the span in the passed context.
+func (c *GRPCClient) CheckWithContext(ctx context.Context, runner plugin2host.Server) error {
 	brokerID := c.broker.NextId()
-	logger.Debug("starting host-side gRPC server")
+	checkID := newCheckID()
+
+	ctx, span := tracing.Start(ctx, "host2plugin.Check")
+	defer span.End()
+	span.SetAttribute("check_id", checkID)
+
+	logger.Debug("starting host-side gRPC server", "check_id", checkID)
 	go c.broker.AcceptAndServe(brokerID, func(opts []grpc.ServerOption) *grpc.Server {
-		opts = append(opts, grpc.UnaryInterceptor(interceptor.RequestLogging("plugin2host")))
+		opts = append(opts, grpc.ChainUnaryInterceptor(interceptor.RequestLogging("plugin2host"), interceptor.ServerTracing("plugin2host")))
 		server := grpc.NewServer(opts...)
 		proto.RegisterRunnerServer(server, &plugin2host.GRPCServer{Impl: runner})
 		return server
 	})
 
-	_, err := c.client.Check(context.Background(), &proto.Check_Request{Runner: brokerID})
+	_, err := c.client.Check(interceptor.InjectTraceContext(ctx), &proto.Check_Request{Runner: brokerID, CheckId: checkID})
 
 	if err != nil {
+		span.SetError(err)
 		return fromproto.Error(err)
 	}
 	return nil
 }
+
+// newCheckID returns a random ID to correlate logs of a Check request between the host and the plugin.
+func newCheckID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
@@ -1,6 +1,7 @@
 package host2plugin
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -13,6 +14,7 @@ import (
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/plugin/internal/plugin2host"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-plugin-sdk/tracing"
 	"github.com/zclconf/go-cty/cty"
 )
 
@@ -365,7 +367,7 @@ func TestConfigSchema(t *testing.T) {
 				t.Fatalf("failed to call ConfigSchema: %s", err)
 			}
 
-			if diff := cmp.Diff(got, test.Want); diff != "" {
+			if diff := cmp.Diff(got, test.Want, cmp.Comparer(func(x, y cty.Type) bool { return x.Equals(y) })); diff != "" {
 				t.Errorf("diff: %s", diff)
 			}
 		})
@@ -407,6 +409,8 @@ func TestApplyGlobalConfig(t *testing.T) {
 				DisabledByDefault: true,
 				Only:              []string{"test_rule1", "test_rule2"},
 				Fix:               true,
+				FormatMode:        tflint.FormatModeChangedBlocks,
+				LogLevel:          "off",
 			},
 			ServerImpl: func(config *tflint.Config) error {
 				want := &tflint.Config{
@@ -417,6 +421,8 @@ func TestApplyGlobalConfig(t *testing.T) {
 					DisabledByDefault: true,
 					Only:              []string{"test_rule1", "test_rule2"},
 					Fix:               true,
+					FormatMode:        tflint.FormatModeChangedBlocks,
+					LogLevel:          "off",
 				}
 
 				if diff := cmp.Diff(config, want); diff != "" {
@@ -426,6 +432,16 @@ func TestApplyGlobalConfig(t *testing.T) {
 			},
 			ErrCheck: neverHappend,
 		},
+		{
+			Name: "invalid log level",
+			Arg:  &tflint.Config{LogLevel: "verbose"},
+			ServerImpl: func(config *tflint.Config) error {
+				return nil
+			},
+			ErrCheck: func(err error) bool {
+				return err == nil || err.Error() != `invalid log level "verbose"`
+			},
+		},
 		{
 			Name: "server returns an error",
 			Arg:  nil,
@@ -580,6 +596,81 @@ block "bar" {
 	}
 }
 
+func TestCheck_tracing(t *testing.T) {
+	exporter := &tracing.MemoryExporter{}
+	tracing.SetExporter(exporter)
+	defer tracing.SetExporter(nil)
+
+	client := startTestGRPCPluginServer(t, newMockRuleSet("test_ruleset", "0.1.0", mockRuleSetImpl{check: func(runner tflint.Runner) error {
+		_, err := runner.GetResourceContent("aws_instance", &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: "instance_type"}},
+		}, nil)
+		return err
+	}}))
+
+	// call VersionConstraints to avoid SDK version incompatible error
+	if _, err := client.VersionConstraints(); err != nil {
+		t.Fatalf("failed to call VersionConstraints: %s", err)
+	}
+	if err := client.ApplyGlobalConfig(&tflint.Config{}); err != nil {
+		t.Fatalf("failed to call ApplyGlobalConfig: %s", err)
+	}
+
+	ctx, root := tracing.Start(context.Background(), "tflint")
+	if err := client.CheckWithContext(ctx, &mockServer{}); err != nil {
+		t.Fatalf("failed to call Check: %s", err)
+	}
+	root.End()
+
+	spans := map[string]*tracing.SpanData{}
+	for _, span := range exporter.Spans() {
+		key := span.Name
+		if kind, exists := span.Attributes["span.kind"]; exists {
+			key += "/" + kind.(string)
+		}
+		spans[key] = span
+	}
+
+	parents := map[string]string{
+		"host2plugin.Check":                   "tflint",
+		"plugin2host.GetFiles/client":         "Check",
+		"plugin2host.GetFiles/server":         "plugin2host.GetFiles/client",
+		"Check":                               "host2plugin.Check",
+		"rule.Check":                          "Check",
+		"plugin2host.GetModuleContent/client": "rule.Check",
+		"plugin2host.GetModuleContent/server": "plugin2host.GetModuleContent/client",
+	}
+	for name, parent := range parents {
+		span, exists := spans[name]
+		if !exists {
+			t.Fatalf(`span "%s" is not recorded`, name)
+		}
+		if span.TraceID != root.SpanContext().TraceID.String() {
+			t.Errorf(`span "%s" has the trace ID "%s", but want "%s"`, name, span.TraceID, root.SpanContext().TraceID)
+		}
+		if span.ParentSpanID != spans[parent].SpanID {
+			t.Errorf(`span "%s" is not a child of "%s"`, name, parent)
+		}
+	}
+
+	if diff := cmp.Diff(spans["rule.Check"].Attributes, map[string]interface{}{"rule": "mock_rule"}); diff != "" {
+		t.Errorf("diff: %s", diff)
+	}
+	if diff := cmp.Diff(spans["host2plugin.Check"].Attributes["check_id"], spans["Check"].Attributes["check_id"]); diff != "" {
+		t.Errorf("diff: %s", diff)
+	}
+	want := map[string]interface{}{
+		"rpc.method":         "/proto.Runner/GetModuleContent",
+		"span.kind":          "client",
+		"schema.size":        2,
+		"content.attributes": 0,
+		"content.blocks":     0,
+	}
+	if diff := cmp.Diff(spans["plugin2host.GetModuleContent/client"].Attributes, want); diff != "" {
+		t.Errorf("diff: %s", diff)
+	}
+}
+
 var _ plugin2host.Server = &mockServer{}
 
 type mockServer struct {
@@ -600,6 +691,10 @@ func (s *mockServer) GetModulePath() []string {
 	return []string{}
 }
 
+func (s *mockServer) GetModuleSource() plugin2host.ModuleSource {
+	return plugin2host.ModuleSource{Local: true}
+}
+
 func (s *mockServer) GetModuleContent(schema *hclext.BodySchema, opts tflint.GetModuleContentOption) (*hclext.BodyContent, hcl.Diagnostics) {
 	return &hclext.BodyContent{}, hcl.Diagnostics{}
 }
@@ -619,11 +714,11 @@ func (s *mockServer) EvaluateExpr(expr hcl.Expression, opts tflint.EvaluateExprO
 	return cty.Value{}, nil
 }
 
-func (s *mockServer) EmitIssue(rule tflint.Rule, message string, location hcl.Range, fixable bool) (bool, error) {
+func (s *mockServer) EmitIssue(rule tflint.Rule, message string, location hcl.Range, fixable bool, fixes []plugin2host.Fix) (bool, error) {
 	return true, nil
 }
 
-func (s *mockServer) ApplyChanges(sources map[string][]byte) error {
+func (s *mockServer) ApplyChanges(sources map[string][]byte, edits []tflint.TextEdit) error {
 	if s.impl.applyChanges != nil {
 		return s.impl.applyChanges(sources)
 	}
@@ -707,6 +802,30 @@ resource "aws_instance" "foo" {
 			},
 			ErrCheck: neverHappend,
 		},
+		{
+			Name: "rule logger",
+			Arg: func() plugin2host.Server {
+				return &mockServer{}
+			},
+			ServerImpl: func(runner tflint.Runner) error {
+				args := runner.Logger().ImpliedArgs()
+				got := map[interface{}]interface{}{}
+				for i := 0; i+1 < len(args); i += 2 {
+					got[args[i]] = args[i+1]
+				}
+				if checkID, ok := got["check_id"].(string); !ok || len(checkID) != 16 {
+					return fmt.Errorf("unexpected check ID: %#v", got["check_id"])
+				}
+				delete(got, "check_id")
+
+				want := map[interface{}]interface{}{"rule": "mock_rule", "module": "root"}
+				if diff := cmp.Diff(got, want); diff != "" {
+					return fmt.Errorf("diff: %s", diff)
+				}
+				return nil
+			},
+			ErrCheck: neverHappend,
+		},
 		{
 			Name: "host server returns an error",
 			Arg: func() plugin2host.Server {
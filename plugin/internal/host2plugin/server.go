@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-linters/tflint-plugin-sdk/plugin/internal/proto"
 	"github.com/terraform-linters/tflint-plugin-sdk/plugin/internal/toproto"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-plugin-sdk/tracing"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -110,6 +111,11 @@ func (s *GRPCServer) ApplyGlobalConfig(ctx context.Context, req *proto.ApplyGlob
 	}
 
 	s.config = fromproto.Config(req.Config)
+	if s.config.LogLevel != "" {
+		if err := logger.SetLevel(s.config.LogLevel); err != nil {
+			return nil, toproto.Error(codes.InvalidArgument, err)
+		}
+	}
 	if err := s.impl.ApplyGlobalConfig(s.config); err != nil {
 		return nil, toproto.Error(codes.FailedPrecondition, err)
 	}
@@ -135,8 +141,13 @@ func (s *GRPCServer) ApplyConfig(ctx context.Context, req *proto.ApplyConfig_Req
 // Check calls plugin rules with a gRPC client that can send requests
 // to the host process.
 func (s *GRPCServer) Check(ctx context.Context, req *proto.Check_Request) (*proto.Check_Response, error) {
-	conn, err := s.broker.Dial(req.Runner)
+	ctx, span := tracing.Start(interceptor.ExtractTraceContext(ctx), "Check")
+	defer span.End()
+	span.SetAttribute("check_id", req.CheckId)
+
+	conn, err := s.broker.DialWithOptions(req.Runner, grpc.WithChainUnaryInterceptor(interceptor.ClientTracing("plugin2host")))
 	if err != nil {
+		span.SetError(err)
 		return nil, toproto.Error(codes.InvalidArgument, err)
 	}
 	defer conn.Close()
@@ -144,25 +155,52 @@ func (s *GRPCServer) Check(ctx context.Context, req *proto.Check_Request) (*prot
 	client := proto.NewRunnerClient(conn)
 	resp, err := client.GetFiles(ctx, &proto.GetFiles_Request{})
 	if err != nil {
+		span.SetError(err)
 		return nil, toproto.Error(codes.FailedPrecondition, err)
 	}
 
-	internalRunner := &plugin2host.GRPCClient{Client: client, Fixer: internal.NewFixer(resp.Files), FixEnabled: s.config.Fix}
+	internalRunner := &plugin2host.GRPCClient{Client: client, Fixer: internal.NewFixer(resp.Files), FixEnabled: s.config.Fix, CheckID: req.CheckId}
 	runner, err := s.impl.NewRunner(internalRunner)
 	if err != nil {
+		span.SetError(err)
 		return nil, toproto.Error(codes.FailedPrecondition, err)
 	}
 
-	for _, rule := range s.impl.BuiltinImpl().EnabledRules {
-		if err := rule.Check(runner); err != nil {
-			return nil, toproto.Error(codes.Aborted, fmt.Errorf(`failed to check "%s" rule: %s`, rule.Name(), err))
-		}
-		if internalRunner.Fixer.HasChanges() {
-			internalRunner.Fixer.FormatChanges()
-			if err := internalRunner.ApplyChanges(); err != nil {
-				return nil, toproto.Error(codes.Aborted, fmt.Errorf(`failed to apply fixes by "%s" rule: %s`, rule.Name(), err))
-			}
+	rules := s.impl.BuiltinImpl().EnabledRules
+	span.SetAttribute("rules", len(rules))
+	for _, rule := range rules {
+		if err := s.checkRule(ctx, rule, runner, internalRunner); err != nil {
+			span.SetError(err)
+			return nil, toproto.Error(codes.Aborted, err)
 		}
 	}
 	return &proto.Check_Response{}, nil
 }
+
+// checkRule calls the rule and applies fixes by the rule within a span of the rule.
+func (s *GRPCServer) checkRule(ctx context.Context, rule tflint.Rule, runner tflint.Runner, internalRunner *plugin2host.GRPCClient) error {
+	ctx, span := tracing.Start(ctx, "rule.Check")
+	defer span.End()
+	span.SetAttribute("rule", rule.Name())
+
+	formatMode := s.config.FormatMode
+	if !rule.FormatFixes() {
+		formatMode = tflint.FormatModeNone
+	}
+	internalRunner.Fixer.SetFormatMode(formatMode)
+	internalRunner.RuleName = rule.Name()
+	internalRunner.Context = ctx
+
+	if err := rule.Check(runner); err != nil {
+		span.SetError(err)
+		return fmt.Errorf(`failed to check "%s" rule: %s`, rule.Name(), err)
+	}
+	if internalRunner.Fixer.HasChanges() {
+		internalRunner.Fixer.FormatChanges()
+		if err := internalRunner.ApplyChanges(); err != nil {
+			span.SetError(err)
+			return fmt.Errorf(`failed to apply fixes by "%s" rule: %s`, rule.Name(), err)
+		}
+	}
+	return nil
+}
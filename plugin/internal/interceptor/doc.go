@@ -1,4 +1,4 @@
 // Package interceptor contains gRPC interceptors.
 // This package is not intended to be used directly from plugins.
-// Its main use today is to insert shared processes such as logging.
+// Its main use today is to insert shared processes such as logging and tracing.
 package interceptor
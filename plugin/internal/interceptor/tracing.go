@@ -0,0 +1,139 @@
+package interceptor
+
+import (
+	"context"
+	"path"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/plugin/internal/proto"
+	"github.com/terraform-linters/tflint-plugin-sdk/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// traceParentKey is the gRPC metadata key for propagating the span context.
+const traceParentKey = "traceparent"
+
+// InjectTraceContext returns a context that propagates the span context in the passed context
+// to the server via the outgoing metadata.
+func InjectTraceContext(ctx context.Context) context.Context {
+	sc := tracing.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, traceParentKey, sc.TraceParent())
+}
+
+// ExtractTraceContext returns a context that has the span context propagated from the client.
+func ExtractTraceContext(ctx context.Context) context.Context {
+	values := metadata.ValueFromIncomingContext(ctx, traceParentKey)
+	if len(values) == 0 {
+		return ctx
+	}
+	sc, err := tracing.ParseTraceParent(values[0])
+	if err != nil {
+		logger.Debug("failed to parse the propagated span context", "err", err)
+		return ctx
+	}
+	return tracing.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// ClientTracing is an interceptor that records a span for each gRPC request
+// and propagates the span context to the server.
+func ClientTracing(direction string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := startRPCSpan(ctx, direction, method, "client", req)
+		defer span.End()
+
+		err := invoker(InjectTraceContext(ctx), method, req, reply, cc, opts...)
+		if err != nil {
+			span.SetError(err)
+		} else {
+			setResponseAttributes(span, reply)
+		}
+		return err
+	}
+}
+
+// ServerTracing is an interceptor that records a span for each gRPC request
+// as a child of the span propagated from the client.
+func ServerTracing(direction string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := startRPCSpan(ExtractTraceContext(ctx), direction, info.FullMethod, "server", req)
+		defer span.End()
+
+		ret, err := handler(ctx, req)
+		if err != nil {
+			span.SetError(err)
+		} else {
+			setResponseAttributes(span, ret)
+		}
+		return ret, err
+	}
+}
+
+func startRPCSpan(ctx context.Context, direction string, method string, kind string, req interface{}) (context.Context, *tracing.Span) {
+	ctx, span := tracing.Start(ctx, direction+"."+path.Base(method))
+	if span == nil {
+		return ctx, nil
+	}
+	span.SetAttribute("rpc.method", method)
+	span.SetAttribute("span.kind", kind)
+
+	switch r := req.(type) {
+	case *proto.GetModuleContent_Request:
+		span.SetAttribute("schema.size", schemaSize(r.Schema))
+	case *proto.GetRuleConfigContent_Request:
+		span.SetAttribute("schema.size", schemaSize(r.Schema))
+	case *proto.EvaluateExprs_Request:
+		span.SetAttribute("requests", len(r.Requests))
+	}
+	return ctx, span
+}
+
+func setResponseAttributes(span *tracing.Span, resp interface{}) {
+	if span == nil {
+		return
+	}
+
+	switch r := resp.(type) {
+	case *proto.GetModuleContent_Response:
+		attributes, blocks := contentSize(r.Content)
+		span.SetAttribute("content.attributes", attributes)
+		span.SetAttribute("content.blocks", blocks)
+	case *proto.GetRuleConfigContent_Response:
+		attributes, blocks := contentSize(r.Content)
+		span.SetAttribute("content.attributes", attributes)
+		span.SetAttribute("content.blocks", blocks)
+	case *proto.GetFiles_Response:
+		span.SetAttribute("files", len(r.Files))
+	case *proto.EvaluateExprs_Response:
+		span.SetAttribute("results", len(r.Results))
+	}
+}
+
+// schemaSize returns the total number of attributes and blocks in the schema, including nested ones.
+func schemaSize(schema *proto.BodySchema) int {
+	if schema == nil {
+		return 0
+	}
+	size := len(schema.Attributes) + len(schema.Blocks)
+	for _, block := range schema.Blocks {
+		size += schemaSize(block.Body)
+	}
+	return size
+}
+
+// contentSize returns the total number of attributes and blocks in the content, including nested ones.
+func contentSize(content *proto.BodyContent) (int, int) {
+	if content == nil {
+		return 0, 0
+	}
+	attributes, blocks := len(content.Attributes), len(content.Blocks)
+	for _, block := range content.Blocks {
+		a, b := contentSize(block.Body)
+		attributes += a
+		blocks += b
+	}
+	return attributes, blocks
+}
@@ -6,9 +6,11 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"reflect"
+	"path/filepath"
 	"strings"
+	"sync"
 
+	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	hcljson "github.com/hashicorp/hcl/v2/json"
@@ -33,13 +35,32 @@ type GRPCClient struct {
 	Client     proto.RunnerClient
 	Fixer      *internal.Fixer
 	FixEnabled bool
+	// CheckID and RuleName are tagged to logs emitted via Logger.
+	CheckID  string
+	RuleName string
+	// Context is used for requests to the server. It propagates the span of the rule being checked.
+	Context context.Context
+
+	modulePathOnce sync.Once
+	modulePath     string
+
+	schemaSupportOnce sync.Once
+	schemaSupported   bool
+	schemaSupportErr  error
 }
 
 var _ tflint.Runner = &GRPCClient{}
 
+func (c *GRPCClient) context() context.Context {
+	if c.Context == nil {
+		return context.Background()
+	}
+	return c.Context
+}
+
 // GetOriginalwd gets the original working directory.
 func (c *GRPCClient) GetOriginalwd() (string, error) {
-	resp, err := c.Client.GetOriginalwd(context.Background(), &proto.GetOriginalwd_Request{})
+	resp, err := c.Client.GetOriginalwd(c.context(), &proto.GetOriginalwd_Request{})
 	if err != nil {
 		if st, ok := status.FromError(err); ok && st.Code() == codes.Unimplemented {
 			// Originalwd is available in TFLint v0.44+
@@ -53,7 +74,7 @@ func (c *GRPCClient) GetOriginalwd() (string, error) {
 
 // GetModulePath gets the current module path address.
 func (c *GRPCClient) GetModulePath() (addrs.Module, error) {
-	resp, err := c.Client.GetModulePath(context.Background(), &proto.GetModulePath_Request{})
+	resp, err := c.Client.GetModulePath(c.context(), &proto.GetModulePath_Request{})
 	if err != nil {
 		return nil, fromproto.Error(err)
 	}
@@ -123,25 +144,41 @@ func (c *GRPCClient) GetModuleContent(schema *hclext.BodySchema, opts *tflint.Ge
 		opts = &tflint.GetModuleContentOption{}
 	}
 
+	if err := c.checkRecursiveMode(schema); err != nil {
+		return nil, err
+	}
+
 	req := &proto.GetModuleContent_Request{
 		Schema: toproto.BodySchema(schema),
 		Option: toproto.GetModuleContentOption(opts),
 	}
-	resp, err := c.Client.GetModuleContent(context.Background(), req)
+	resp, err := c.Client.GetModuleContent(c.context(), req)
 	if err != nil {
 		return nil, fromproto.Error(err)
 	}
 
 	body, diags := fromproto.BodyContent(resp.Content)
+	if !diags.HasErrors() && usesAttributeConstraints(schema) {
+		// Older hosts ignore the constraints, so validate the content here instead.
+		supported, err := c.hostSupportsSchema()
+		if err != nil {
+			return nil, err
+		}
+		if !supported {
+			diags = diags.Extend(hclext.ValidateContent(body, schema))
+		}
+	}
 	if diags.HasErrors() {
 		err = diags
 	}
+	// Hosts do not always return blocks in order, so sort them here to guarantee it.
+	body.SortBlocks()
 	return body, err
 }
 
 // GetFile returns hcl.File based on the passed file name.
 func (c *GRPCClient) GetFile(file string) (*hcl.File, error) {
-	resp, err := c.Client.GetFile(context.Background(), &proto.GetFile_Request{Name: file})
+	resp, err := c.Client.GetFile(c.context(), &proto.GetFile_Request{Name: file})
 	if err != nil {
 		return nil, fromproto.Error(err)
 	}
@@ -162,7 +199,7 @@ func (c *GRPCClient) GetFile(file string) (*hcl.File, error) {
 
 // GetFiles returns bytes of hcl.File in the self module context.
 func (c *GRPCClient) GetFiles() (map[string]*hcl.File, error) {
-	resp, err := c.Client.GetFiles(context.Background(), &proto.GetFiles_Request{})
+	resp, err := c.Client.GetFiles(c.context(), &proto.GetFiles_Request{})
 	if err != nil {
 		return nil, fromproto.Error(err)
 	}
@@ -320,9 +357,14 @@ func (c *GRPCClient) WalkExpressions(walker tflint.ExprWalker) hcl.Diagnostics {
 // DecodeRuleConfig guesses the schema of the rule config from the passed interface and sends the schema to GRPC server.
 // Content retrieved based on the schema is decoded into the passed interface.
 func (c *GRPCClient) DecodeRuleConfig(name string, ret interface{}) error {
-	resp, err := c.Client.GetRuleConfigContent(context.Background(), &proto.GetRuleConfigContent_Request{
+	schema := hclext.ImpliedBodySchema(ret)
+	if err := c.checkRecursiveMode(schema); err != nil {
+		return err
+	}
+
+	resp, err := c.Client.GetRuleConfigContent(c.context(), &proto.GetRuleConfigContent_Request{
 		Name:   name,
-		Schema: toproto.BodySchema(hclext.ImpliedBodySchema(ret)),
+		Schema: toproto.BodySchema(schema),
 	})
 	if err != nil {
 		return fromproto.Error(err)
@@ -343,60 +385,77 @@ func (c *GRPCClient) DecodeRuleConfig(name string, ret interface{}) error {
 	return nil
 }
 
-var errRefTy = reflect.TypeOf((*error)(nil)).Elem()
-
 // EvaluateExpr evals the passed expression based on the type.
 // Passing a callback function instead of a value as the target will invoke the callback,
 // passing the evaluated value to the argument.
 func (c *GRPCClient) EvaluateExpr(expr hcl.Expression, target interface{}, opts *tflint.EvaluateExprOption) error {
-	rval := reflect.ValueOf(target)
-	rty := rval.Type()
-
-	var callback bool
-	switch rty.Kind() {
-	case reflect.Func:
-		// Callback must meet the following requirements:
-		//   - It must be a function
-		//   - It must take an argument
-		//   - It must return an error
-		if !(rty.NumIn() == 1 && rty.NumOut() == 1 && rty.Out(0).Implements(errRefTy)) {
-			panic(`callback must be of type "func (v T) error"`)
-		}
-		callback = true
-		target = reflect.New(rty.In(0)).Interface()
+	target, complete := internal.PrepareTarget(target)
+	return complete(c.evaluateExpr(expr, target, opts))
+}
 
-	case reflect.Pointer:
-		// ok
-	default:
-		panic("target value is not a pointer or function")
+func (c *GRPCClient) evaluateExpr(expr hcl.Expression, target interface{}, opts *tflint.EvaluateExprOption) error {
+	req, ty, err := c.evaluateExprRequest(expr, target, opts, map[string]*hcl.File{})
+	if err != nil {
+		return err
 	}
 
-	err := c.evaluateExpr(expr, target, opts)
-	if !callback {
-		// error should be handled in the caller
-		return err
+	resp, err := c.Client.EvaluateExpr(c.context(), req)
+	if err != nil {
+		return fromproto.Error(err)
 	}
 
+	return evaluateExprResponse(expr, target, ty, resp)
+}
+
+// EvaluateExprs evals the passed expressions in a single round trip.
+// Each request is evaluated in the same way as EvaluateExpr, and the errors are returned in the same order.
+func (c *GRPCClient) EvaluateExprs(requests []tflint.EvaluateExprRequest) ([]error, error) {
+	targets := make([]interface{}, len(requests))
+	completes := make([]func(error) error, len(requests))
+	types := make([]cty.Type, len(requests))
+	reqs := make([]*proto.EvaluateExpr_Request, len(requests))
+
+	// Files are cached because many expressions usually belong to the same file.
+	files := map[string]*hcl.File{}
+	for i, request := range requests {
+		targets[i], completes[i] = internal.PrepareTarget(request.Target)
+
+		var err error
+		reqs[i], types[i], err = c.evaluateExprRequest(request.Expr, targets[i], request.Option, files)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.Client.EvaluateExprs(c.context(), &proto.EvaluateExprs_Request{Requests: reqs})
 	if err != nil {
-		// If it cannot be represented as a Go value, exit without invoking the callback rather than returning an error.
-		if errors.Is(err, tflint.ErrUnknownValue) ||
-			errors.Is(err, tflint.ErrNullValue) ||
-			errors.Is(err, tflint.ErrSensitive) ||
-			errors.Is(err, tflint.ErrEphemeral) ||
-			errors.Is(err, tflint.ErrUnevaluable) {
-			return nil
+		if st, ok := status.FromError(err); ok && st.Code() == codes.Unimplemented {
+			// EvaluateExprs is not available in older TFLint versions.
+			// Fallback to evaluating expressions one by one.
+			errs := make([]error, len(requests))
+			for i, request := range requests {
+				errs[i] = completes[i](c.evaluateExpr(request.Expr, targets[i], request.Option))
+			}
+			return errs, nil
 		}
-		return err
+		return nil, fromproto.Error(err)
+	}
+	if len(resp.Results) != len(requests) {
+		return nil, fmt.Errorf("%d results are returned for %d requests", len(resp.Results), len(requests))
 	}
 
-	rerr := rval.Call([]reflect.Value{reflect.ValueOf(target).Elem()})
-	if rerr[0].IsNil() {
-		return nil
+	errs := make([]error, len(requests))
+	for i, result := range resp.Results {
+		if result.Error != nil {
+			errs[i] = completes[i](fromproto.ErrorDetail(result.Error))
+			continue
+		}
+		errs[i] = completes[i](evaluateExprResponse(requests[i].Expr, targets[i], types[i], result.Response))
 	}
-	return rerr[0].Interface().(error)
+	return errs, nil
 }
 
-func (c *GRPCClient) evaluateExpr(expr hcl.Expression, target interface{}, opts *tflint.EvaluateExprOption) error {
+func (c *GRPCClient) evaluateExprRequest(expr hcl.Expression, target interface{}, opts *tflint.EvaluateExprOption, files map[string]*hcl.File) (*proto.EvaluateExpr_Request, cty.Type, error) {
 	if opts == nil {
 		opts = &tflint.EvaluateExprOption{}
 	}
@@ -406,56 +465,51 @@ func (c *GRPCClient) evaluateExpr(expr hcl.Expression, target interface{}, opts
 		ty = *opts.WantType
 	} else {
 		switch target.(type) {
-		case *string:
-			ty = cty.String
-		case *int:
-			ty = cty.Number
-		case *bool:
-			ty = cty.Bool
-		case *[]string:
-			ty = cty.List(cty.String)
-		case *[]int:
-			ty = cty.List(cty.Number)
-		case *[]bool:
-			ty = cty.List(cty.Bool)
-		case *map[string]string:
-			ty = cty.Map(cty.String)
-		case *map[string]int:
-			ty = cty.Map(cty.Number)
-		case *map[string]bool:
-			ty = cty.Map(cty.Bool)
 		case *cty.Value:
 			ty = cty.DynamicPseudoType
+		case *tflint.PartialValue:
+			ty = cty.DynamicPseudoType
 		default:
-			panic(fmt.Sprintf("unsupported target type: %T", target))
+			// Infer the type from the Go type, such as *string, *[]int, and structs with cty tags.
+			var err error
+			ty, err = internal.ImpliedType(target)
+			if err != nil {
+				panic(fmt.Sprintf("unsupported target type: %T", target))
+			}
 		}
 	}
 	tyby, err := json.MarshalType(ty)
 	if err != nil {
-		return err
+		return nil, ty, err
 	}
 
-	file, err := c.GetFile(expr.Range().Filename)
-	if err != nil {
-		return err
+	file, exists := files[expr.Range().Filename]
+	if !exists {
+		file, err = c.GetFile(expr.Range().Filename)
+		if err != nil {
+			return nil, ty, err
+		}
+		files[expr.Range().Filename] = file
 	}
 
-	resp, err := c.Client.EvaluateExpr(
-		context.Background(),
-		&proto.EvaluateExpr_Request{
-			Expression: toproto.Expression(expr, file.Bytes),
-			Option:     &proto.EvaluateExpr_Option{Type: tyby, ModuleCtx: toproto.ModuleCtxType(opts.ModuleCtx)},
-		},
-	)
-	if err != nil {
-		return fromproto.Error(err)
-	}
+	return &proto.EvaluateExpr_Request{
+		Expression: toproto.Expression(expr, file.Bytes),
+		Option:     &proto.EvaluateExpr_Option{Type: tyby, ModuleCtx: toproto.ModuleCtxType(opts.ModuleCtx)},
+	}, ty, nil
+}
 
+func evaluateExprResponse(expr hcl.Expression, target interface{}, ty cty.Type, resp *proto.EvaluateExpr_Response) error {
 	val, err := fromproto.Value(resp.Value, ty, resp.Marks)
 	if err != nil {
 		return err
 	}
 
+	// PartialValue receives the value as it is, including unknown and marked values.
+	if partial, ok := target.(*tflint.PartialValue); ok {
+		*partial = *tflint.NewPartialValue(val)
+		return nil
+	}
+
 	if ty == cty.DynamicPseudoType {
 		return gocty.FromCtyValue(val, target)
 	}
@@ -468,6 +522,10 @@ func (c *GRPCClient) evaluateExpr(expr hcl.Expression, target interface{}, opts
 			return false, tflint.ErrUnknownValue
 		}
 		if v.IsNull() {
+			if internal.IsNullable(target, path) {
+				// Nullable fields like *string can receive null values
+				return false, nil
+			}
 			logger.Debug(fmt.Sprintf("null value found in %s", expr.Range()))
 			return false, tflint.ErrNullValue
 		}
@@ -485,12 +543,27 @@ func (c *GRPCClient) evaluateExpr(expr hcl.Expression, target interface{}, opts
 		return err
 	}
 
-	return gocty.FromCtyValue(val, target)
+	return internal.FromCtyValue(val, target)
+}
+
+// TraceValue traces the value of the passed expression through local values and variables
+// in the files of the current module.
+func (c *GRPCClient) TraceValue(expr hcl.Expression) (*tflint.ValueSource, error) {
+	files, err := c.GetFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	source, diags := internal.TraceValue(files, expr)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return source, nil
 }
 
 // EmitIssue emits the issue with the passed rule, message, location
 func (c *GRPCClient) EmitIssue(rule tflint.Rule, message string, location hcl.Range) error {
-	_, err := c.Client.EmitIssue(context.Background(), &proto.EmitIssue_Request{Rule: toproto.Rule(rule), Message: message, Range: toproto.Range(location)})
+	_, err := c.Client.EmitIssue(c.context(), &proto.EmitIssue_Request{Rule: toproto.Rule(rule), Message: message, Range: toproto.Range(location)})
 	if err != nil {
 		return fromproto.Error(err)
 	}
@@ -502,25 +575,46 @@ func (c *GRPCClient) EmitIssue(rule tflint.Rule, message string, location hcl.Ra
 // If the fix function returns ErrFixNotSupported, the emitted issue will not
 // be marked as fixable.
 func (c *GRPCClient) EmitIssueWithFix(rule tflint.Rule, message string, location hcl.Range, fixFunc func(f tflint.Fixer) error) error {
-	var fixable bool
-	var fixErr error
+	return c.EmitIssueWithFixes(rule, message, location, []tflint.Fix{{Func: fixFunc}})
+}
 
-	path, err := c.GetModulePath()
+// EmitIssueWithFixes emits the issue with the passed rule, message, location.
+// Invoke the fix functions and send the changes of each fix as alternatives.
+// Only the changes of the default fix are added to the fixer.
+// Fixes that conflict with the fixes of previous issues are sent with the reason.
+// Issues in child modules are fixable only if the module is local.
+// If all fix functions return ErrFixNotSupported, the emitted issue will not
+// be marked as fixable.
+func (c *GRPCClient) EmitIssueWithFixes(rule tflint.Rule, message string, location hcl.Range, fixes []tflint.Fix) error {
+	results := []internal.FixResult{}
+
+	dir, local, err := c.getModuleSource()
 	if err != nil {
-		return fromproto.Error(err)
+		return err
 	}
-	// If the issue is not in the root module, skip the fix.
-	if path.IsRoot() {
-		fixable = true
+	// If the issue is in a remote module, skip the fix.
+	if local {
 		c.Fixer.StashChanges()
+		results = internal.OfferedFixes(c.Fixer.RunFixes(fixes))
+
+		// Never fix files outside the module.
+		if !fixesWithinDir(dir, results) {
+			results = []internal.FixResult{}
+		}
+	}
 
-		fixErr = fixFunc(c.Fixer)
-		if errors.Is(fixErr, tflint.ErrFixNotSupported) {
-			fixable = false
+	protoFixes := make([]*proto.EmitIssue_Fix, len(results))
+	for i, result := range results {
+		protoFixes[i] = &proto.EmitIssue_Fix{Name: result.Name, Changes: result.Changes, Edits: toproto.TextEdits(result.Edits)}
+		if result.Conflict != nil {
+			protoFixes[i].Conflict = result.Conflict.Error()
 		}
 	}
+	// If all fixes conflict with previous fixes, the issue is not fixable.
+	defaultFix := internal.DefaultFix(results)
+	fixable := defaultFix != nil
 
-	resp, err := c.Client.EmitIssue(context.Background(), &proto.EmitIssue_Request{Rule: toproto.Rule(rule), Message: message, Range: toproto.Range(location), Fixable: fixable})
+	resp, err := c.Client.EmitIssue(c.context(), &proto.EmitIssue_Request{Rule: toproto.Rule(rule), Message: message, Range: toproto.Range(location), Fixable: fixable, Fixes: protoFixes})
 	if err != nil {
 		return fromproto.Error(err)
 	}
@@ -529,12 +623,140 @@ func (c *GRPCClient) EmitIssueWithFix(rule tflint.Rule, message string, location
 		c.Fixer.PopChangesFromStash()
 		return nil
 	}
-	return fixErr
+	return defaultFix.Err
+}
+
+// getModuleSource returns the directory of the current module and whether it is local.
+// The root module is always local.
+func (c *GRPCClient) getModuleSource() (string, bool, error) {
+	path, err := c.GetModulePath()
+	if err != nil {
+		return "", false, fromproto.Error(err)
+	}
+	if path.IsRoot() {
+		return "", true, nil
+	}
+
+	resp, err := c.Client.GetModuleSource(c.context(), &proto.GetModuleSource_Request{})
+	if err != nil {
+		// GetModuleSource is not available in older TFLint versions.
+		// In this case, child modules are treated as remote modules.
+		if status.Code(err) == codes.Unimplemented {
+			return "", false, nil
+		}
+		return "", false, fromproto.Error(err)
+	}
+	return resp.Dir, resp.Local, nil
+}
+
+// hostSupportsSchema returns whether the host supports SchemaRecursiveMode and attribute constraints in schemas.
+// Older hosts fail on unknown schema modes, and ignore the type and literal-only constraints of attributes.
+// These are available in the same host versions as GetModuleSource, so the support is detected by calling it.
+func (c *GRPCClient) hostSupportsSchema() (bool, error) {
+	c.schemaSupportOnce.Do(func() {
+		_, err := c.Client.GetModuleSource(c.context(), &proto.GetModuleSource_Request{})
+		if err != nil {
+			if status.Code(err) != codes.Unimplemented {
+				c.schemaSupportErr = fromproto.Error(err)
+			}
+			return
+		}
+		c.schemaSupported = true
+	})
+	return c.schemaSupported, c.schemaSupportErr
+}
+
+// checkRecursiveMode returns an error if the schema uses SchemaRecursiveMode and the host does not support it.
+func (c *GRPCClient) checkRecursiveMode(schema *hclext.BodySchema) error {
+	if !usesRecursiveMode(schema) {
+		return nil
+	}
+	supported, err := c.hostSupportsSchema()
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return errors.New("SchemaRecursiveMode is not supported by this version of TFLint; a newer version is required")
+	}
+	return nil
+}
+
+func usesRecursiveMode(schema *hclext.BodySchema) bool {
+	if schema == nil {
+		return false
+	}
+	if schema.Mode == hclext.SchemaRecursiveMode {
+		return true
+	}
+	for _, block := range schema.Blocks {
+		if usesRecursiveMode(block.Body) {
+			return true
+		}
+	}
+	return false
+}
+
+func usesAttributeConstraints(schema *hclext.BodySchema) bool {
+	if schema == nil {
+		return false
+	}
+	for _, attr := range schema.Attributes {
+		if attr.Type != cty.NilType || attr.LiteralOnly {
+			return true
+		}
+	}
+	for _, block := range schema.Blocks {
+		if usesAttributeConstraints(block.Body) {
+			return true
+		}
+	}
+	return false
+}
+
+func fixesWithinDir(dir string, results []internal.FixResult) bool {
+	for _, result := range results {
+		for filename := range result.Changes {
+			if !withinDir(dir, filename) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func withinDir(dir string, filename string) bool {
+	if dir == "" {
+		return true
+	}
+	rel, err := filepath.Rel(filepath.Clean(dir), filepath.Clean(filename))
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// Logger returns the global logger tagged with the rule name, the module path, and the check ID.
+// The module path is retrieved from the server only once per check.
+func (c *GRPCClient) Logger() hclog.Logger {
+	c.modulePathOnce.Do(func() {
+		path, err := c.GetModulePath()
+		if err != nil {
+			logger.Debug("failed to get the module path for logging", "err", err)
+			return
+		}
+		if path.IsRoot() {
+			c.modulePath = "root"
+		} else {
+			c.modulePath = path.String()
+		}
+	})
+
+	return logger.Logger().With("rule", c.RuleName, "module", c.modulePath, "check_id", c.CheckID)
 }
 
 // ApplyChanges applies the changes in the fixer to the server
 func (c *GRPCClient) ApplyChanges() error {
-	_, err := c.Client.ApplyChanges(context.Background(), &proto.ApplyChanges_Request{Changes: c.Fixer.Changes()})
+	_, err := c.Client.ApplyChanges(c.context(), &proto.ApplyChanges_Request{Changes: c.Fixer.Changes(), Edits: toproto.TextEdits(c.Fixer.Edits())})
 	if err != nil {
 		return fromproto.Error(err)
 	}
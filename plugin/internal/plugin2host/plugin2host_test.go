@@ -1,10 +1,12 @@
 package plugin2host
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -19,7 +21,10 @@ import (
 	"github.com/terraform-linters/tflint-plugin-sdk/terraform/lang/marks"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func startTestGRPCServer(t *testing.T, runner Server) *GRPCClient {
@@ -43,13 +48,14 @@ type mockServer struct {
 type mockServerImpl struct {
 	getOriginalwd        func() string
 	getModulePath        func() []string
+	getModuleSource      func() ModuleSource
 	getModuleContent     func(*hclext.BodySchema, tflint.GetModuleContentOption) (*hclext.BodyContent, hcl.Diagnostics)
 	getFile              func(string) (*hcl.File, error)
 	getFiles             func() map[string][]byte
 	getRuleConfigContent func(string, *hclext.BodySchema) (*hclext.BodyContent, map[string][]byte, error)
 	evaluateExpr         func(hcl.Expression, tflint.EvaluateExprOption) (cty.Value, error)
-	emitIssue            func(tflint.Rule, string, hcl.Range, bool) (bool, error)
-	applyChanges         func(map[string][]byte) error
+	emitIssue            func(tflint.Rule, string, hcl.Range, bool, []Fix) (bool, error)
+	applyChanges         func(map[string][]byte, []tflint.TextEdit) error
 }
 
 func newMockServer(impl mockServerImpl) *mockServer {
@@ -70,6 +76,13 @@ func (s *mockServer) GetModulePath() []string {
 	return []string{}
 }
 
+func (s *mockServer) GetModuleSource() ModuleSource {
+	if s.impl.getModuleSource != nil {
+		return s.impl.getModuleSource()
+	}
+	return ModuleSource{}
+}
+
 func (s *mockServer) GetModuleContent(schema *hclext.BodySchema, opts tflint.GetModuleContentOption) (*hclext.BodyContent, hcl.Diagnostics) {
 	if s.impl.getModuleContent != nil {
 		return s.impl.getModuleContent(schema, opts)
@@ -105,16 +118,16 @@ func (s *mockServer) EvaluateExpr(expr hcl.Expression, opts tflint.EvaluateExprO
 	return cty.Value{}, nil
 }
 
-func (s *mockServer) EmitIssue(rule tflint.Rule, message string, location hcl.Range, fixable bool) (bool, error) {
+func (s *mockServer) EmitIssue(rule tflint.Rule, message string, location hcl.Range, fixable bool, fixes []Fix) (bool, error) {
 	if s.impl.emitIssue != nil {
-		return s.impl.emitIssue(rule, message, location, fixable)
+		return s.impl.emitIssue(rule, message, location, fixable, fixes)
 	}
 	return true, nil
 }
 
-func (s *mockServer) ApplyChanges(sources map[string][]byte) error {
+func (s *mockServer) ApplyChanges(sources map[string][]byte, edits []tflint.TextEdit) error {
 	if s.impl.applyChanges != nil {
-		return s.impl.applyChanges(sources)
+		return s.impl.applyChanges(sources, edits)
 	}
 	return nil
 }
@@ -717,6 +730,141 @@ volume_size = 10`)
 				return err == nil || err.Error() != "test.tf:1,1-5: unexpected error; unexpected error occurred"
 			},
 		},
+		{
+			Name: "typed attribute schema",
+			Args: func() (*hclext.BodySchema, *tflint.GetModuleContentOption) {
+				return &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "volume_size", Type: cty.Number, LiteralOnly: true}},
+				}, nil
+			},
+			ServerImpl: func(schema *hclext.BodySchema, opts tflint.GetModuleContentOption) (*hclext.BodyContent, hcl.Diagnostics) {
+				file := hclFile("test.tf", `volume_size = "large"`)
+				return hclext.Content(file.Body, schema)
+			},
+			Want: func(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, hcl.Diagnostics) {
+				return nil, hcl.Diagnostics{}
+			},
+			ErrCheck: func(err error) bool {
+				return err == nil || err.Error() != `test.tf:1,15-22: Incorrect attribute value type; Inappropriate value for attribute "volume_size": a number is required.`
+			},
+		},
+		{
+			Name: "unordered blocks",
+			Args: func() (*hclext.BodySchema, *tflint.GetModuleContentOption) {
+				return &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{{Type: "resource", LabelNames: []string{"type", "name"}}},
+				}, nil
+			},
+			ServerImpl: func(schema *hclext.BodySchema, opts tflint.GetModuleContentOption) (*hclext.BodyContent, hcl.Diagnostics) {
+				main, diags := hclext.Content(hclFile("main.tf", `resource "aws_instance" "main" {}`).Body, schema)
+				if diags.HasErrors() {
+					return nil, diags
+				}
+				other, diags := hclext.Content(hclFile("a.tf", `resource "aws_instance" "other" {}`).Body, schema)
+				if diags.HasErrors() {
+					return nil, diags
+				}
+				return &hclext.BodyContent{Blocks: append(main.Blocks, other.Blocks...)}, nil
+			},
+			Want: func(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, hcl.Diagnostics) {
+				other, diags := hclext.Content(hclFile("a.tf", `resource "aws_instance" "other" {}`).Body, schema)
+				if diags.HasErrors() {
+					return nil, diags
+				}
+				main, diags := hclext.Content(hclFile("main.tf", `resource "aws_instance" "main" {}`).Body, schema)
+				if diags.HasErrors() {
+					return nil, diags
+				}
+				return &hclext.BodyContent{Attributes: hclext.Attributes{}, Blocks: append(other.Blocks, main.Blocks...)}, nil
+			},
+			ErrCheck: neverHappend,
+		},
+		{
+			Name: "recursive schema",
+			Args: func() (*hclext.BodySchema, *tflint.GetModuleContentOption) {
+				return &hclext.BodySchema{Mode: hclext.SchemaRecursiveMode, Depth: 1}, nil
+			},
+			ServerImpl: func(schema *hclext.BodySchema, opts tflint.GetModuleContentOption) (*hclext.BodyContent, hcl.Diagnostics) {
+				if schema.Mode != hclext.SchemaRecursiveMode || schema.Depth != 1 {
+					return nil, hcl.Diagnostics{{Severity: hcl.DiagError, Summary: "unexpected schema"}}
+				}
+				file := hclFile("test.tf", `
+resource "aws_wafv2_web_acl" "main" {
+  name = "main"
+
+  rule {
+    name = "foo"
+  }
+}`)
+				return hclext.Content(file.Body, schema)
+			},
+			Want: func(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, hcl.Diagnostics) {
+				file := hclFile("test.tf", `
+resource "aws_wafv2_web_acl" "main" {
+  name = "main"
+}`)
+				return hclext.Content(file.Body, schema)
+			},
+			ErrCheck: neverHappend,
+		},
+		{
+			Name: "expanded instances",
+			Args: func() (*hclext.BodySchema, *tflint.GetModuleContentOption) {
+				return &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type:       "resource",
+							LabelNames: []string{"type", "name"},
+							Body: &hclext.BodySchema{
+								Attributes: []hclext.AttributeSchema{{Name: "instance_type"}},
+								Blocks:     []hclext.BlockSchema{{Type: "ebs_block_device"}},
+							},
+						},
+					},
+				}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeExpand}
+			},
+			ServerImpl: func(schema *hclext.BodySchema, opts tflint.GetModuleContentOption) (*hclext.BodyContent, hcl.Diagnostics) {
+				file := hclFile("test.tf", `
+resource "aws_instance" "foo" {
+  instance_type = "t2.micro"
+}
+resource "aws_instance" "bar" {
+  instance_type = "t3.nano"
+
+  ebs_block_device {}
+}`)
+				content, diags := hclext.Content(file.Body, schema)
+				if diags.HasErrors() {
+					return nil, diags
+				}
+				content.Blocks[0].Instance = &hclext.BlockInstance{Key: addrs.IntKey(0)}
+				content.Blocks[1].Instance = &hclext.BlockInstance{Key: addrs.StringKey("prod"), Value: cty.ObjectVal(map[string]cty.Value{"size": cty.StringVal("large")})}
+				content.Blocks[1].Body.Blocks[0].Instance = &hclext.BlockInstance{Key: addrs.IntKey(1), Value: cty.StringVal("gp3").Mark(marks.Sensitive), Dynamic: true}
+				return content, diags
+			},
+			Want: func(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, hcl.Diagnostics) {
+				file := hclFile("test.tf", `
+resource "aws_instance" "foo" {
+  instance_type = "t2.micro"
+}
+resource "aws_instance" "bar" {
+  instance_type = "t3.nano"
+
+  ebs_block_device {}
+}`)
+				content, diags := hclext.Content(file.Body, schema)
+				if diags.HasErrors() {
+					return nil, diags
+				}
+				content.Blocks[0].Instance = &hclext.BlockInstance{Key: addrs.IntKey(0)}
+				content.Blocks[1].Instance = &hclext.BlockInstance{Key: addrs.StringKey("prod"), Value: cty.ObjectVal(map[string]cty.Value{"size": cty.StringVal("large")})}
+				content.Blocks[1].Body.Blocks[0].Instance = &hclext.BlockInstance{Key: addrs.IntKey(1), Value: cty.StringVal("gp3").Mark(marks.Sensitive), Dynamic: true}
+				// Label ranges of unlabeled nested blocks are decoded as an empty slice
+				content.Blocks[1].Body.Blocks[0].LabelRanges = []hcl.Range{}
+				return content, diags
+			},
+			ErrCheck: neverHappend,
+		},
 		{
 			Name: "response body is empty",
 			Args: func() (*hclext.BodySchema, *tflint.GetModuleContentOption) {
@@ -1442,6 +1590,15 @@ func TestEvaluateExpr(t *testing.T) {
 	}
 	objectTy := cty.Object(map[string]cty.Type{"name": cty.String, "enabled": cty.Bool})
 
+	// test struct for type inference
+	type Ingress struct {
+		Port        int            `cty:"port"`
+		Description *string        `cty:"description"`
+		Timeout     *time.Duration `cty:"timeout"`
+	}
+	description := "HTTP"
+	timeout := 30 * time.Second
+
 	tests := []struct {
 		Name        string
 		Expr        hcl.Expression
@@ -1695,6 +1852,32 @@ func TestEvaluateExpr(t *testing.T) {
 			GetFileImpl: fileExists,
 			ErrCheck:    neverHappend,
 		},
+		{
+			Name:       "object variable to struct without wantType",
+			Expr:       hclExpr(`[{ port = 80, description = "HTTP", timeout = "30s" }, { port = 443 }]`),
+			TargetType: reflect.TypeOf([]Ingress{}),
+			ServerImpl: func(expr hcl.Expression, opts tflint.EvaluateExprOption) (cty.Value, error) {
+				want := cty.List(cty.ObjectWithOptionalAttrs(map[string]cty.Type{
+					"port":        cty.Number,
+					"description": cty.String,
+					"timeout":     cty.String,
+				}, []string{"description", "timeout"}))
+				if !opts.WantType.Equals(want) {
+					return cty.Value{}, fmt.Errorf("wantType should be %s, but got %s", want.GoString(), opts.WantType.GoString())
+				}
+				val, err := evalExpr(expr, nil)
+				if err != nil {
+					return cty.Value{}, err
+				}
+				return convert.Convert(val, *opts.WantType)
+			},
+			Want: []Ingress{
+				{Port: 80, Description: &description, Timeout: &timeout},
+				{Port: 443},
+			},
+			GetFileImpl: fileExists,
+			ErrCheck:    neverHappend,
+		},
 		{
 			Name:       "JSON expr",
 			Expr:       jsonExpr(`"${var.foo}"`),
@@ -2037,6 +2220,58 @@ func TestEvaluateExpr(t *testing.T) {
 			GetFileImpl: fileExists,
 			ErrCheck:    neverHappend,
 		},
+		{
+			Name:       "partially unknown as tflint.PartialValue",
+			Expr:       hclExpr(`{ foo = var.foo, bar = var.bar, baz = "baz" }`),
+			TargetType: reflect.TypeOf(tflint.PartialValue{}),
+			ServerImpl: func(expr hcl.Expression, opts tflint.EvaluateExprOption) (cty.Value, error) {
+				if *opts.WantType != cty.DynamicPseudoType {
+					return cty.Value{}, errors.New("wantType should be pseudo type")
+				}
+				return evalExpr(expr, &hcl.EvalContext{
+					Variables: map[string]cty.Value{
+						"var": cty.ObjectVal(map[string]cty.Value{
+							"foo": cty.UnknownVal(cty.String),
+							"bar": cty.StringVal("secret").Mark(marks.Sensitive),
+						}),
+					},
+				})
+			},
+			Want: tflint.PartialValue{
+				Value: cty.ObjectVal(map[string]cty.Value{
+					"foo": cty.UnknownVal(cty.String),
+					"bar": cty.StringVal("secret"),
+					"baz": cty.StringVal("baz"),
+				}),
+				UnknownPaths:   []cty.Path{cty.GetAttrPath("foo")},
+				SensitivePaths: []cty.Path{cty.GetAttrPath("bar")},
+				EphemeralPaths: []cty.Path{},
+			},
+			GetFileImpl: fileExists,
+			ErrCheck:    neverHappend,
+		},
+		{
+			Name:       "ephemeral as tflint.PartialValue",
+			Expr:       hclExpr(`var.foo`),
+			TargetType: reflect.TypeOf(tflint.PartialValue{}),
+			ServerImpl: func(expr hcl.Expression, opts tflint.EvaluateExprOption) (cty.Value, error) {
+				return evalExpr(expr, &hcl.EvalContext{
+					Variables: map[string]cty.Value{
+						"var": cty.MapVal(map[string]cty.Value{
+							"foo": cty.StringVal("bar").Mark(marks.Ephemeral),
+						}),
+					},
+				})
+			},
+			Want: tflint.PartialValue{
+				Value:          cty.StringVal("bar"),
+				UnknownPaths:   []cty.Path{},
+				SensitivePaths: []cty.Path{},
+				EphemeralPaths: []cty.Path{{}},
+			},
+			GetFileImpl: fileExists,
+			ErrCheck:    neverHappend,
+		},
 	}
 
 	for _, test := range tests {
@@ -2056,6 +2291,10 @@ func TestEvaluateExpr(t *testing.T) {
 				cmp.Comparer(func(x, y cty.Value) bool {
 					return x.GoString() == y.GoString()
 				}),
+				cmp.Comparer(func(x, y cty.Path) bool {
+					return x.Equals(y)
+				}),
+				cmpopts.IgnoreUnexported(tflint.PartialValue{}),
 			}
 			if diff := cmp.Diff(got, test.Want, opts); diff != "" {
 				t.Errorf("diff: %s", diff)
@@ -2396,6 +2635,210 @@ func TestEvaluateExpr_callback(t *testing.T) {
 	}
 }
 
+type unimplementedEvaluateExprsServer struct {
+	*GRPCServer
+}
+
+func (s *unimplementedEvaluateExprsServer) EvaluateExprs(context.Context, *proto.EvaluateExprs_Request) (*proto.EvaluateExprs_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method EvaluateExprs not implemented")
+}
+
+func TestEvaluateExprs(t *testing.T) {
+	file, diags := hclsyntax.ParseConfig([]byte(`
+literal  = "foo"
+unknown  = var.unknown
+secret   = var.secret
+failed   = var.failed
+callback = "bar"
+`), "main.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	attributes, diags := file.Body.JustAttributes()
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	evalExpr := func(expr hcl.Expression, ctx *hcl.EvalContext) (cty.Value, error) {
+		val, diags := expr.Value(ctx)
+		if diags.HasErrors() {
+			return cty.Value{}, diags
+		}
+		return val, nil
+	}
+
+	var getFileCalls int
+	impl := mockServerImpl{
+		getFile: func(filename string) (*hcl.File, error) {
+			getFileCalls++
+			return file, nil
+		},
+		evaluateExpr: func(expr hcl.Expression, opts tflint.EvaluateExprOption) (cty.Value, error) {
+			if expr.Range() == attributes["failed"].Expr.Range() {
+				return cty.NilVal, errors.New("failed to evaluate")
+			}
+			return evalExpr(expr, &hcl.EvalContext{
+				Variables: map[string]cty.Value{
+					"var": cty.ObjectVal(map[string]cty.Value{
+						"unknown": cty.UnknownVal(cty.String),
+						"secret":  cty.StringVal("secret").Mark(marks.Sensitive),
+					}),
+				},
+			})
+		},
+	}
+
+	tests := []struct {
+		name   string
+		client func(t *testing.T) *GRPCClient
+	}{
+		{
+			name: "batch",
+			client: func(t *testing.T) *GRPCClient {
+				return startTestGRPCServer(t, newMockServer(impl))
+			},
+		},
+		{
+			name: "fallback",
+			client: func(t *testing.T) *GRPCClient {
+				conn, _ := plugin.TestGRPCConn(t, func(server *grpc.Server) {
+					proto.RegisterRunnerServer(server, &unimplementedEvaluateExprsServer{&GRPCServer{Impl: newMockServer(impl)}})
+				})
+				return &GRPCClient{Client: proto.NewRunnerClient(conn)}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			getFileCalls = 0
+			client := test.client(t)
+
+			var literal, unknown, secret, failed, callback string
+			errs, err := client.EvaluateExprs([]tflint.EvaluateExprRequest{
+				{Expr: attributes["literal"].Expr, Target: &literal},
+				{Expr: attributes["unknown"].Expr, Target: &unknown},
+				{Expr: attributes["secret"].Expr, Target: &secret},
+				{Expr: attributes["failed"].Expr, Target: &failed},
+				{Expr: attributes["callback"].Expr, Target: func(v string) error {
+					callback = v
+					return nil
+				}},
+				{Expr: attributes["unknown"].Expr, Target: func(v string) error {
+					t.Fatal("callback should not be called for unknown values")
+					return nil
+				}},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(errs) != 6 {
+				t.Fatalf("6 errors are expected, but got %d", len(errs))
+			}
+			if errs[0] != nil || literal != "foo" {
+				t.Errorf("literal: value=%s, err=%s", literal, errs[0])
+			}
+			if !errors.Is(errs[1], tflint.ErrUnknownValue) {
+				t.Errorf("unknown: expected ErrUnknownValue, but got %s", errs[1])
+			}
+			if !errors.Is(errs[2], tflint.ErrSensitive) {
+				t.Errorf("secret: expected ErrSensitive, but got %s", errs[2])
+			}
+			if errs[3] == nil || errs[3].Error() != "failed to evaluate" {
+				t.Errorf(`failed: expected "failed to evaluate", but got %s`, errs[3])
+			}
+			if errs[4] != nil || callback != "bar" {
+				t.Errorf("callback: value=%s, err=%s", callback, errs[4])
+			}
+			if errs[5] != nil {
+				t.Errorf("unknown callback: expected no error, but got %s", errs[5])
+			}
+		})
+	}
+
+	// All expressions belong to the same file, so the file is fetched only once in a batch.
+	getFileCalls = 0
+	client := startTestGRPCServer(t, newMockServer(impl))
+	var literal string
+	if _, err := client.EvaluateExprs([]tflint.EvaluateExprRequest{
+		{Expr: attributes["literal"].Expr, Target: &literal},
+		{Expr: attributes["callback"].Expr, Target: &literal},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if getFileCalls != 1 {
+		t.Errorf("GetFile should be called once, but called %d times", getFileCalls)
+	}
+}
+
+func TestTraceValue(t *testing.T) {
+	// default error check helper
+	neverHappend := func(err error) bool { return err != nil }
+
+	// test util functions
+	hclExpr := func(expr string) hcl.Expression {
+		file, diags := hclsyntax.ParseConfig([]byte(fmt.Sprintf(`expr = %s`, expr)), "main.tf", hcl.InitialPos)
+		if diags.HasErrors() {
+			panic(diags)
+		}
+		attributes, diags := file.Body.JustAttributes()
+		if diags.HasErrors() {
+			panic(diags)
+		}
+		return attributes["expr"].Expr
+	}
+
+	tests := []struct {
+		Name     string
+		Expr     hcl.Expression
+		Files    map[string][]byte
+		Want     []string
+		ErrCheck func(error) bool
+	}{
+		{
+			Name: "trace through locals and variables",
+			Expr: hclExpr(`local.name`),
+			Files: map[string][]byte{
+				"main.tf":      []byte(`expr = local.name`),
+				"locals.tf":    []byte("locals {\n  name = \"${var.prefix}-app\"\n}\n"),
+				"variables.tf": []byte("variable \"prefix\" {\n  default = \"prod\"\n}\n"),
+			},
+			Want:     []string{`variables.tf:2,13-19`},
+			ErrCheck: neverHappend,
+		},
+		{
+			Name: "no references",
+			Expr: hclExpr(`"t2.micro"`),
+			Files: map[string][]byte{
+				"main.tf": []byte(`expr = "t2.micro"`),
+			},
+			Want:     []string{`main.tf:1,8-18`},
+			ErrCheck: neverHappend,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			client := startTestGRPCServer(t, newMockServer(mockServerImpl{
+				getFiles: func() map[string][]byte { return test.Files },
+			}))
+
+			source, err := client.TraceValue(test.Expr)
+			if test.ErrCheck(err) {
+				t.Fatalf("failed to call TraceValue: %s", err)
+			}
+
+			got := []string{}
+			for _, origin := range source.Origins() {
+				got = append(got, origin.Expr.Range().String())
+			}
+			if diff := cmp.Diff(test.Want, got); diff != "" {
+				t.Errorf("diff: %s", diff)
+			}
+		})
+	}
+}
+
 // test rule for TestEmitIssue
 type Rule struct {
 	tflint.DefaultRule
@@ -2414,7 +2857,7 @@ func TestEmitIssue(t *testing.T) {
 	tests := []struct {
 		Name       string
 		Args       func() (tflint.Rule, string, hcl.Range)
-		ServerImpl func(tflint.Rule, string, hcl.Range, bool) (bool, error)
+		ServerImpl func(tflint.Rule, string, hcl.Range, bool, []Fix) (bool, error)
 		ErrCheck   func(error) bool
 	}{
 		{
@@ -2422,7 +2865,7 @@ func TestEmitIssue(t *testing.T) {
 			Args: func() (tflint.Rule, string, hcl.Range) {
 				return &Rule{}, "this is test", hcl.Range{Filename: "test.tf", Start: hcl.Pos{Line: 2, Column: 2}, End: hcl.Pos{Line: 2, Column: 10}}
 			},
-			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool) (bool, error) {
+			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool, fixes []Fix) (bool, error) {
 				if rule.Name() != "test_rule" {
 					return false, fmt.Errorf("rule.Name() should be test_rule, but %s", rule.Name())
 				}
@@ -2455,7 +2898,7 @@ func TestEmitIssue(t *testing.T) {
 			Args: func() (tflint.Rule, string, hcl.Range) {
 				return &Rule{}, "this is test", hcl.Range{Filename: "test.tf", Start: hcl.Pos{Line: 2, Column: 2}, End: hcl.Pos{Line: 2, Column: 10}}
 			},
-			ServerImpl: func(tflint.Rule, string, hcl.Range, bool) (bool, error) {
+			ServerImpl: func(tflint.Rule, string, hcl.Range, bool, []Fix) (bool, error) {
 				return false, errors.New("unexpected error")
 			},
 			ErrCheck: func(err error) bool {
@@ -2488,7 +2931,7 @@ func TestEmitIssueWithFix(t *testing.T) {
 	tests := []struct {
 		Name       string
 		Args       func() (tflint.Rule, string, hcl.Range, func(tflint.Fixer) error)
-		ServerImpl func(tflint.Rule, string, hcl.Range, bool) (bool, error)
+		ServerImpl func(tflint.Rule, string, hcl.Range, bool, []Fix) (bool, error)
 		ModulePath []string
 		DisableFix bool
 		ErrCheck   func(error) bool
@@ -2507,7 +2950,7 @@ func TestEmitIssueWithFix(t *testing.T) {
 						)
 					}
 			},
-			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool) (bool, error) {
+			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool, fixes []Fix) (bool, error) {
 				if rule.Name() != "test_rule" {
 					return false, fmt.Errorf("rule.Name() should be test_rule, but %s", rule.Name())
 				}
@@ -2554,7 +2997,7 @@ func TestEmitIssueWithFix(t *testing.T) {
 						)
 					}
 			},
-			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool) (bool, error) {
+			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool, fixes []Fix) (bool, error) {
 				if fixable != false {
 					return false, fmt.Errorf("fixable should be false, but %t", fixable)
 				}
@@ -2580,7 +3023,7 @@ func TestEmitIssueWithFix(t *testing.T) {
 						return tflint.ErrFixNotSupported
 					}
 			},
-			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool) (bool, error) {
+			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool, fixes []Fix) (bool, error) {
 				if fixable != false {
 					return false, fmt.Errorf("fixable should be false, but %t", fixable)
 				}
@@ -2602,7 +3045,7 @@ func TestEmitIssueWithFix(t *testing.T) {
 						)
 					}
 			},
-			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool) (bool, error) {
+			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool, fixes []Fix) (bool, error) {
 				if fixable != true {
 					return false, fmt.Errorf("fixable should be true, but %t", fixable)
 				}
@@ -2625,7 +3068,7 @@ func TestEmitIssueWithFix(t *testing.T) {
 						)
 					}
 			},
-			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool) (bool, error) {
+			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool, fixes []Fix) (bool, error) {
 				if fixable != true {
 					return false, fmt.Errorf("fixable should be true, but %t", fixable)
 				}
@@ -2650,7 +3093,7 @@ func TestEmitIssueWithFix(t *testing.T) {
 						return errors.New("unexpected error")
 					}
 			},
-			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool) (bool, error) {
+			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool, fixes []Fix) (bool, error) {
 				if fixable != true {
 					return false, fmt.Errorf("fixable should be true, but %t", fixable)
 				}
@@ -2676,7 +3119,7 @@ func TestEmitIssueWithFix(t *testing.T) {
 						)
 					}
 			},
-			ServerImpl: func(tflint.Rule, string, hcl.Range, bool) (bool, error) {
+			ServerImpl: func(tflint.Rule, string, hcl.Range, bool, []Fix) (bool, error) {
 				return false, errors.New("unexpected error")
 			},
 			ErrCheck: func(err error) bool {
@@ -2716,6 +3159,327 @@ func TestEmitIssueWithFix(t *testing.T) {
 	}
 }
 
+func TestEmitIssueWithFixes(t *testing.T) {
+	// default error check helper
+	neverHappend := func(err error) bool { return err != nil }
+	getFiles := func() map[string][]byte {
+		return map[string][]byte{
+			"test.tf": []byte(`foo = "bar"`),
+		}
+	}
+	replace := func(text string) func(tflint.Fixer) error {
+		return func(f tflint.Fixer) error {
+			return f.ReplaceText(
+				hcl.Range{Filename: "test.tf", Start: hcl.Pos{Byte: 6}, End: hcl.Pos{Byte: 11}},
+				text,
+			)
+		}
+	}
+
+	tests := []struct {
+		Name       string
+		Fixes      []tflint.Fix
+		ServerImpl func(tflint.Rule, string, hcl.Range, bool, []Fix) (bool, error)
+		ModulePath []string
+		ErrCheck   func(error) bool
+		Changes    map[string]string
+	}{
+		{
+			Name: "emit issue with fixes",
+			Fixes: []tflint.Fix{
+				{Name: "Replace with baz", Func: replace(`"baz"`)},
+				{Name: "Not supported", Func: func(tflint.Fixer) error { return tflint.ErrFixNotSupported }},
+				{Name: "Replace with qux", Func: replace(`"qux"`)},
+			},
+			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool, fixes []Fix) (bool, error) {
+				if fixable != true {
+					return false, fmt.Errorf("fixable should be true, but %t", fixable)
+				}
+				rng := hcl.Range{Filename: "test.tf", Start: hcl.Pos{Line: 1, Column: 7, Byte: 6}, End: hcl.Pos{Line: 1, Column: 12, Byte: 11}}
+				want := []Fix{
+					{
+						Name:    "Replace with baz",
+						Changes: map[string][]byte{"test.tf": []byte(`foo = "baz"`)},
+						Edits:   []tflint.TextEdit{{Range: rng, NewText: `"baz"`}},
+					},
+					{
+						Name:    "Replace with qux",
+						Changes: map[string][]byte{"test.tf": []byte(`foo = "qux"`)},
+						Edits:   []tflint.TextEdit{{Range: rng, NewText: `"qux"`}},
+					},
+				}
+				if diff := cmp.Diff(fixes, want); diff != "" {
+					return false, fmt.Errorf("diff: %s", diff)
+				}
+				return true, nil
+			},
+			ErrCheck: neverHappend,
+			Changes: map[string]string{
+				"test.tf": `foo = "baz"`,
+			},
+		},
+		{
+			Name: "no fixes are supported",
+			Fixes: []tflint.Fix{
+				{Name: "Not supported", Func: func(tflint.Fixer) error { return tflint.ErrFixNotSupported }},
+			},
+			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool, fixes []Fix) (bool, error) {
+				if fixable != false {
+					return false, fmt.Errorf("fixable should be false, but %t", fixable)
+				}
+				if len(fixes) != 0 {
+					return false, fmt.Errorf("fixes should be empty, but %d", len(fixes))
+				}
+				return true, nil
+			},
+			ErrCheck: neverHappend,
+			Changes:  map[string]string{},
+		},
+		{
+			Name: "child modules",
+			Fixes: []tflint.Fix{
+				{Name: "Replace with baz", Func: replace(`"baz"`)},
+			},
+			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool, fixes []Fix) (bool, error) {
+				if fixable != false {
+					return false, fmt.Errorf("fixable should be false, but %t", fixable)
+				}
+				if len(fixes) != 0 {
+					return false, fmt.Errorf("fixes should be empty, but %d", len(fixes))
+				}
+				return true, nil
+			},
+			ModulePath: []string{"module", "child"},
+			ErrCheck:   neverHappend,
+			Changes:    map[string]string{},
+		},
+		{
+			Name: "fix is not applied",
+			Fixes: []tflint.Fix{
+				{Name: "Replace with baz", Func: replace(`"baz"`)},
+				{Name: "Replace with qux", Func: replace(`"qux"`)},
+			},
+			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool, fixes []Fix) (bool, error) {
+				return false, nil
+			},
+			ErrCheck: neverHappend,
+			Changes:  map[string]string{},
+		},
+		{
+			Name: "default fix raises an error",
+			Fixes: []tflint.Fix{
+				{Name: "Broken", Func: func(tflint.Fixer) error { return errors.New("unexpected error") }},
+				{Name: "Replace with qux", Func: replace(`"qux"`)},
+			},
+			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool, fixes []Fix) (bool, error) {
+				return true, nil
+			},
+			ErrCheck: func(err error) bool {
+				return err == nil || err.Error() != "unexpected error"
+			},
+			Changes: map[string]string{},
+		},
+		{
+			Name: "alternative fix raises an error",
+			Fixes: []tflint.Fix{
+				{Name: "Replace with baz", Func: replace(`"baz"`)},
+				{Name: "Broken", Func: func(f tflint.Fixer) error {
+					if err := replace(`"qux"`)(f); err != nil {
+						return err
+					}
+					return errors.New("unexpected error")
+				}},
+			},
+			ServerImpl: func(rule tflint.Rule, message string, location hcl.Range, fixable bool, fixes []Fix) (bool, error) {
+				if len(fixes) != 1 || fixes[0].Name != "Replace with baz" {
+					return false, fmt.Errorf("only the default fix should be sent, but %#v", fixes)
+				}
+				return true, nil
+			},
+			ErrCheck: neverHappend,
+			Changes: map[string]string{
+				"test.tf": `foo = "baz"`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			client := startTestGRPCServer(
+				t,
+				newMockServer(mockServerImpl{
+					getFiles:      getFiles,
+					getModulePath: func() []string { return test.ModulePath },
+					emitIssue:     test.ServerImpl,
+				}),
+			)
+			client.FixEnabled = true
+
+			err := client.EmitIssueWithFixes(&Rule{}, "this is test", hcl.Range{Filename: "test.tf"}, test.Fixes)
+			if test.ErrCheck(err) {
+				t.Fatalf("failed to call EmitIssueWithFixes: %s", err)
+			}
+
+			got := map[string]string{}
+			for name, content := range client.Fixer.Changes() {
+				got[name] = string(content)
+			}
+			if diff := cmp.Diff(got, test.Changes); diff != "" {
+				t.Fatalf("diff: %s", diff)
+			}
+		})
+	}
+}
+
+type unimplementedGetModuleSourceServer struct {
+	*GRPCServer
+}
+
+func (s *unimplementedGetModuleSourceServer) GetModuleSource(context.Context, *proto.GetModuleSource_Request) (*proto.GetModuleSource_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetModuleSource not implemented")
+}
+
+func TestGetModuleContent_legacyHost(t *testing.T) {
+	impl := newMockServer(mockServerImpl{
+		getModuleContent: func(schema *hclext.BodySchema, opts tflint.GetModuleContentOption) (*hclext.BodyContent, hcl.Diagnostics) {
+			if schema.Mode == hclext.SchemaRecursiveMode {
+				t.Fatal("recursive schema should not be sent to legacy hosts")
+			}
+			// Legacy hosts ignore the type and literal-only constraints.
+			file, diags := hclsyntax.ParseConfig([]byte(`foo = "bar"`), "test.tf", hcl.InitialPos)
+			if diags.HasErrors() {
+				return nil, diags
+			}
+			return hclext.Content(file.Body, &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "foo"}}})
+		},
+		getFiles: func() map[string][]byte {
+			return map[string][]byte{"test.tf": []byte(`foo = "bar"`)}
+		},
+	})
+	conn, _ := plugin.TestGRPCConn(t, func(server *grpc.Server) {
+		proto.RegisterRunnerServer(server, &unimplementedGetModuleSourceServer{&GRPCServer{Impl: impl}})
+	})
+	client := &GRPCClient{Client: proto.NewRunnerClient(conn)}
+
+	if _, err := client.GetModuleContent(&hclext.BodySchema{}, nil); err != nil {
+		t.Fatalf("failed to call GetModuleContent: %s", err)
+	}
+
+	_, err := client.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "resource", LabelNames: []string{"type", "name"}, Body: &hclext.BodySchema{Mode: hclext.SchemaRecursiveMode}},
+		},
+	}, nil)
+	if err == nil || err.Error() != "SchemaRecursiveMode is not supported by this version of TFLint; a newer version is required" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Attribute constraints are validated by the plugin instead.
+	_, err = client.GetModuleContent(&hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "foo", Type: cty.Bool}},
+	}, nil)
+	if err == nil || err.Error() != `test.tf:1,7-12: Incorrect attribute value type; Inappropriate value for attribute "foo": a bool is required.` {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestEmitIssueWithFixes_modules(t *testing.T) {
+	getFiles := func() map[string][]byte {
+		return map[string][]byte{
+			"modules/vpc/main.tf": []byte(`foo = "bar"`),
+			"main.tf":             []byte(`foo = "bar"`),
+		}
+	}
+	replace := func(filename string) []tflint.Fix {
+		return []tflint.Fix{
+			{
+				Name: "Replace with baz",
+				Func: func(f tflint.Fixer) error {
+					return f.ReplaceText(hcl.Range{Filename: filename, Start: hcl.Pos{Byte: 6}, End: hcl.Pos{Byte: 11}}, `"baz"`)
+				},
+			},
+		}
+	}
+	emitIssue := func(want bool) func(tflint.Rule, string, hcl.Range, bool, []Fix) (bool, error) {
+		return func(rule tflint.Rule, message string, location hcl.Range, fixable bool, fixes []Fix) (bool, error) {
+			if fixable != want {
+				return false, fmt.Errorf("fixable should be %t, but %t", want, fixable)
+			}
+			return true, nil
+		}
+	}
+
+	tests := []struct {
+		name    string
+		source  ModuleSource
+		fixes   []tflint.Fix
+		legacy  bool
+		fixable bool
+		changes map[string]string
+	}{
+		{
+			name:    "local module",
+			source:  ModuleSource{Dir: "modules/vpc", Local: true},
+			fixes:   replace("modules/vpc/main.tf"),
+			fixable: true,
+			changes: map[string]string{"modules/vpc/main.tf": `foo = "baz"`},
+		},
+		{
+			name:    "files outside the local module",
+			source:  ModuleSource{Dir: "modules/vpc", Local: true},
+			fixes:   replace("main.tf"),
+			fixable: false,
+			changes: map[string]string{},
+		},
+		{
+			name:    "remote module",
+			source:  ModuleSource{Dir: ".terraform/modules/vpc", Local: false},
+			fixes:   replace("modules/vpc/main.tf"),
+			fixable: false,
+			changes: map[string]string{},
+		},
+		{
+			name:    "legacy host",
+			source:  ModuleSource{Dir: "modules/vpc", Local: true},
+			fixes:   replace("modules/vpc/main.tf"),
+			legacy:  true,
+			fixable: false,
+			changes: map[string]string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			impl := newMockServer(mockServerImpl{
+				getFiles:        getFiles,
+				getModulePath:   func() []string { return []string{"module", "vpc"} },
+				getModuleSource: func() ModuleSource { return test.source },
+				emitIssue:       emitIssue(test.fixable),
+			})
+			conn, _ := plugin.TestGRPCConn(t, func(server *grpc.Server) {
+				if test.legacy {
+					proto.RegisterRunnerServer(server, &unimplementedGetModuleSourceServer{&GRPCServer{Impl: impl}})
+				} else {
+					proto.RegisterRunnerServer(server, &GRPCServer{Impl: impl})
+				}
+			})
+			client := &GRPCClient{Client: proto.NewRunnerClient(conn), Fixer: internal.NewFixer(getFiles()), FixEnabled: true}
+
+			if err := client.EmitIssueWithFixes(&Rule{}, "this is test", hcl.Range{Filename: "modules/vpc/main.tf"}, test.fixes); err != nil {
+				t.Fatalf("failed to call EmitIssueWithFixes: %s", err)
+			}
+
+			got := map[string]string{}
+			for name, content := range client.Fixer.Changes() {
+				got[name] = string(content)
+			}
+			if diff := cmp.Diff(got, test.changes); diff != "" {
+				t.Fatalf("diff: %s", diff)
+			}
+		})
+	}
+}
+
 func TestApplyChanges(t *testing.T) {
 	// default error check helper
 	neverHappend := func(err error) bool { return err != nil }
@@ -2728,7 +3492,7 @@ func TestApplyChanges(t *testing.T) {
 	tests := []struct {
 		name       string
 		fix        func(tflint.Fixer) error
-		serverImpl func(map[string][]byte) error
+		serverImpl func(map[string][]byte, []tflint.TextEdit) error
 		errCheck   func(error) bool
 	}{
 		{
@@ -2739,12 +3503,20 @@ func TestApplyChanges(t *testing.T) {
 					"baz",
 				)
 			},
-			serverImpl: func(files map[string][]byte) error {
+			serverImpl: func(files map[string][]byte, edits []tflint.TextEdit) error {
 				if diff := cmp.Diff(files, map[string][]byte{
 					"test.tf": []byte(`foo = "baz"`),
 				}); diff != "" {
 					return fmt.Errorf("diff: %s", diff)
 				}
+				if diff := cmp.Diff(edits, []tflint.TextEdit{
+					{
+						Range:   hcl.Range{Filename: "test.tf", Start: hcl.Pos{Line: 1, Column: 8, Byte: 7}, End: hcl.Pos{Line: 1, Column: 11, Byte: 10}},
+						NewText: "baz",
+					},
+				}); diff != "" {
+					return fmt.Errorf("diff: %s", diff)
+				}
 				return nil
 			},
 			errCheck: neverHappend,
@@ -2757,7 +3529,7 @@ func TestApplyChanges(t *testing.T) {
 					"baz",
 				)
 			},
-			serverImpl: func(files map[string][]byte) error {
+			serverImpl: func(files map[string][]byte, edits []tflint.TextEdit) error {
 				return errors.New("unexpected error")
 			},
 			errCheck: func(err error) bool {
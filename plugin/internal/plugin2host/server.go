@@ -29,14 +29,40 @@ var _ proto.RunnerServer = &GRPCServer{}
 type Server interface {
 	GetOriginalwd() string
 	GetModulePath() []string
+	GetModuleSource() ModuleSource
 	GetModuleContent(*hclext.BodySchema, tflint.GetModuleContentOption) (*hclext.BodyContent, hcl.Diagnostics)
 	GetFile(string) (*hcl.File, error)
 	// For performance, GetFiles returns map[string][]bytes instead of map[string]*hcl.File.
 	GetFiles(tflint.ModuleCtxType) map[string][]byte
 	GetRuleConfigContent(string, *hclext.BodySchema) (*hclext.BodyContent, map[string][]byte, error)
 	EvaluateExpr(hcl.Expression, tflint.EvaluateExprOption) (cty.Value, error)
-	EmitIssue(rule tflint.Rule, message string, location hcl.Range, fixable bool) (bool, error)
-	ApplyChanges(map[string][]byte) error
+	// If the issue is fixable, fixes are the alternative edit sets. The first one without conflicts is the default.
+	// Note that fixes may be empty even if the issue is fixable when the plugin is built with older SDKs.
+	EmitIssue(rule tflint.Rule, message string, location hcl.Range, fixable bool, fixes []Fix) (bool, error)
+	// Edits are the text edits on the sources before the changes. They are empty when the plugin
+	// is built with older SDKs. Note that the edits are not formatted unlike the changes.
+	ApplyChanges(changes map[string][]byte, edits []tflint.TextEdit) error
+}
+
+// ModuleSource is the source of the current module.
+// Dir is the directory of the module relative to the root module, like "modules/vpc".
+// The names of the module files are expected to start with Dir.
+// Local is true if the module is the root module or a local module that can be fixed.
+type ModuleSource struct {
+	Dir   string
+	Local bool
+}
+
+// Fix is an alternative fix of an issue.
+// Changes are the contents of the files after the fix, and are based on the changes of the previous fixes
+// that have not yet been applied by ApplyChanges. Edits are the text edits made by the fix only.
+// If Conflict is not empty, it is the reason why the fix is not available because of a conflict
+// with the fix of a previous issue. In this case, Changes and Edits are empty.
+type Fix struct {
+	Name     string
+	Changes  map[string][]byte
+	Edits    []tflint.TextEdit
+	Conflict string
 }
 
 // GetOriginalwd gets the original working directory.
@@ -49,6 +75,12 @@ func (s *GRPCServer) GetModulePath(ctx context.Context, req *proto.GetModulePath
 	return &proto.GetModulePath_Response{Path: s.Impl.GetModulePath()}, nil
 }
 
+// GetModuleSource gets the source of the current module.
+func (s *GRPCServer) GetModuleSource(ctx context.Context, req *proto.GetModuleSource_Request) (*proto.GetModuleSource_Response, error) {
+	source := s.Impl.GetModuleSource()
+	return &proto.GetModuleSource_Response{Dir: source.Dir, Local: source.Local}, nil
+}
+
 // GetModuleContent gets the contents of the module based on the schema.
 func (s *GRPCServer) GetModuleContent(ctx context.Context, req *proto.GetModuleContent_Request) (*proto.GetModuleContent_Response, error) {
 	if req.Schema == nil {
@@ -58,8 +90,12 @@ func (s *GRPCServer) GetModuleContent(ctx context.Context, req *proto.GetModuleC
 		return nil, status.Error(codes.InvalidArgument, "option should not be null")
 	}
 
+	schema, err := fromproto.BodySchema(req.Schema)
+	if err != nil {
+		return nil, toproto.Error(codes.InvalidArgument, err)
+	}
 	opts := fromproto.GetModuleContentOption(req.Option)
-	body, diags := s.Impl.GetModuleContent(fromproto.BodySchema(req.Schema), opts)
+	body, diags := s.Impl.GetModuleContent(schema, opts)
 	if diags.HasErrors() {
 		return nil, toproto.Error(codes.FailedPrecondition, diags)
 	}
@@ -101,7 +137,11 @@ func (s *GRPCServer) GetRuleConfigContent(ctx context.Context, req *proto.GetRul
 		return nil, status.Error(codes.InvalidArgument, "schema should not be null")
 	}
 
-	body, sources, err := s.Impl.GetRuleConfigContent(req.Name, fromproto.BodySchema(req.Schema))
+	schema, err := fromproto.BodySchema(req.Schema)
+	if err != nil {
+		return nil, toproto.Error(codes.InvalidArgument, err)
+	}
+	body, sources, err := s.Impl.GetRuleConfigContent(req.Name, schema)
 	if err != nil {
 		return nil, toproto.Error(codes.FailedPrecondition, err)
 	}
@@ -152,6 +192,22 @@ func (s *GRPCServer) EvaluateExpr(ctx context.Context, req *proto.EvaluateExpr_R
 	return &proto.EvaluateExpr_Response{Value: val, Marks: marks}, nil
 }
 
+// EvaluateExprs evals the passed expressions in a single round trip.
+// Errors are returned per expression, so the failure of an expression does not affect others.
+func (s *GRPCServer) EvaluateExprs(ctx context.Context, req *proto.EvaluateExprs_Request) (*proto.EvaluateExprs_Response, error) {
+	results := make([]*proto.EvaluateExprs_Result, len(req.Requests))
+	for i, r := range req.Requests {
+		resp, err := s.EvaluateExpr(ctx, r)
+		if err != nil {
+			results[i] = &proto.EvaluateExprs_Result{Error: toproto.ErrorDetail(err)}
+			continue
+		}
+		results[i] = &proto.EvaluateExprs_Result{Response: resp}
+	}
+
+	return &proto.EvaluateExprs_Response{Results: results}, nil
+}
+
 // EmitIssue emits the issue with the passed rule, message, location
 func (s *GRPCServer) EmitIssue(ctx context.Context, req *proto.EmitIssue_Request) (*proto.EmitIssue_Response, error) {
 	if req.Rule == nil {
@@ -161,7 +217,12 @@ func (s *GRPCServer) EmitIssue(ctx context.Context, req *proto.EmitIssue_Request
 		return nil, status.Error(codes.InvalidArgument, "range should not be null")
 	}
 
-	applied, err := s.Impl.EmitIssue(fromproto.Rule(req.Rule), req.Message, fromproto.Range(req.Range), req.Fixable)
+	fixes := make([]Fix, len(req.Fixes))
+	for i, fix := range req.Fixes {
+		fixes[i] = Fix{Name: fix.Name, Changes: fix.Changes, Edits: fromproto.TextEdits(fix.Edits), Conflict: fix.Conflict}
+	}
+
+	applied, err := s.Impl.EmitIssue(fromproto.Rule(req.Rule), req.Message, fromproto.Range(req.Range), req.Fixable, fixes)
 	if err != nil {
 		return nil, toproto.Error(codes.FailedPrecondition, err)
 	}
@@ -170,7 +231,7 @@ func (s *GRPCServer) EmitIssue(ctx context.Context, req *proto.EmitIssue_Request
 
 // ApplyChanges applies the passed changes.
 func (s *GRPCServer) ApplyChanges(ctx context.Context, req *proto.ApplyChanges_Request) (*proto.ApplyChanges_Response, error) {
-	err := s.Impl.ApplyChanges(req.Changes)
+	err := s.Impl.ApplyChanges(req.Changes, fromproto.TextEdits(req.Edits))
 	if err != nil {
 		return nil, toproto.Error(codes.InvalidArgument, err)
 	}
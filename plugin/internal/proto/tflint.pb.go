@@ -75,6 +75,7 @@ const (
 	SchemaMode_SCHEMA_MODE_UNSPECIFIED     SchemaMode = 0
 	SchemaMode_SCHEMA_MODE_DEFAULT         SchemaMode = 1
 	SchemaMode_SCHEMA_MODE_JUST_ATTRIBUTES SchemaMode = 2
+	SchemaMode_SCHEMA_MODE_RECURSIVE       SchemaMode = 3
 )
 
 // Enum value maps for SchemaMode.
@@ -83,11 +84,13 @@ var (
 		0: "SCHEMA_MODE_UNSPECIFIED",
 		1: "SCHEMA_MODE_DEFAULT",
 		2: "SCHEMA_MODE_JUST_ATTRIBUTES",
+		3: "SCHEMA_MODE_RECURSIVE",
 	}
 	SchemaMode_value = map[string]int32{
 		"SCHEMA_MODE_UNSPECIFIED":     0,
 		"SCHEMA_MODE_DEFAULT":         1,
 		"SCHEMA_MODE_JUST_ATTRIBUTES": 2,
+		"SCHEMA_MODE_RECURSIVE":       3,
 	}
 )
 
@@ -164,6 +167,58 @@ func (ErrorCode) EnumDescriptor() ([]byte, []int) {
 	return file_tflint_proto_rawDescGZIP(), []int{2}
 }
 
+type ApplyGlobalConfig_FormatMode int32
+
+const (
+	ApplyGlobalConfig_FORMAT_MODE_UNSPECIFIED    ApplyGlobalConfig_FormatMode = 0
+	ApplyGlobalConfig_FORMAT_MODE_FILE           ApplyGlobalConfig_FormatMode = 1
+	ApplyGlobalConfig_FORMAT_MODE_CHANGED_BLOCKS ApplyGlobalConfig_FormatMode = 2
+	ApplyGlobalConfig_FORMAT_MODE_NONE           ApplyGlobalConfig_FormatMode = 3
+)
+
+// Enum value maps for ApplyGlobalConfig_FormatMode.
+var (
+	ApplyGlobalConfig_FormatMode_name = map[int32]string{
+		0: "FORMAT_MODE_UNSPECIFIED",
+		1: "FORMAT_MODE_FILE",
+		2: "FORMAT_MODE_CHANGED_BLOCKS",
+		3: "FORMAT_MODE_NONE",
+	}
+	ApplyGlobalConfig_FormatMode_value = map[string]int32{
+		"FORMAT_MODE_UNSPECIFIED":    0,
+		"FORMAT_MODE_FILE":           1,
+		"FORMAT_MODE_CHANGED_BLOCKS": 2,
+		"FORMAT_MODE_NONE":           3,
+	}
+)
+
+func (x ApplyGlobalConfig_FormatMode) Enum() *ApplyGlobalConfig_FormatMode {
+	p := new(ApplyGlobalConfig_FormatMode)
+	*p = x
+	return p
+}
+
+func (x ApplyGlobalConfig_FormatMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApplyGlobalConfig_FormatMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_tflint_proto_enumTypes[3].Descriptor()
+}
+
+func (ApplyGlobalConfig_FormatMode) Type() protoreflect.EnumType {
+	return &file_tflint_proto_enumTypes[3]
+}
+
+func (x ApplyGlobalConfig_FormatMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApplyGlobalConfig_FormatMode.Descriptor instead.
+func (ApplyGlobalConfig_FormatMode) EnumDescriptor() ([]byte, []int) {
+	return file_tflint_proto_rawDescGZIP(), []int{6, 0}
+}
+
 type GetModuleContent_ExpandMode int32
 
 const (
@@ -197,11 +252,11 @@ func (x GetModuleContent_ExpandMode) String() string {
 }
 
 func (GetModuleContent_ExpandMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_tflint_proto_enumTypes[3].Descriptor()
+	return file_tflint_proto_enumTypes[4].Descriptor()
 }
 
 func (GetModuleContent_ExpandMode) Type() protoreflect.EnumType {
-	return &file_tflint_proto_enumTypes[3]
+	return &file_tflint_proto_enumTypes[4]
 }
 
 func (x GetModuleContent_ExpandMode) Number() protoreflect.EnumNumber {
@@ -210,7 +265,7 @@ func (x GetModuleContent_ExpandMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetModuleContent_ExpandMode.Descriptor instead.
 func (GetModuleContent_ExpandMode) EnumDescriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{11, 0}
+	return file_tflint_proto_rawDescGZIP(), []int{12, 0}
 }
 
 type EmitIssue_Severity int32
@@ -249,11 +304,11 @@ func (x EmitIssue_Severity) String() string {
 }
 
 func (EmitIssue_Severity) Descriptor() protoreflect.EnumDescriptor {
-	return file_tflint_proto_enumTypes[4].Descriptor()
+	return file_tflint_proto_enumTypes[5].Descriptor()
 }
 
 func (EmitIssue_Severity) Type() protoreflect.EnumType {
-	return &file_tflint_proto_enumTypes[4]
+	return &file_tflint_proto_enumTypes[5]
 }
 
 func (x EmitIssue_Severity) Number() protoreflect.EnumNumber {
@@ -262,7 +317,7 @@ func (x EmitIssue_Severity) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EmitIssue_Severity.Descriptor instead.
 func (EmitIssue_Severity) EnumDescriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{16, 0}
+	return file_tflint_proto_rawDescGZIP(), []int{18, 0}
 }
 
 type GetName struct {
@@ -661,6 +716,42 @@ func (*GetModulePath) Descriptor() ([]byte, []int) {
 	return file_tflint_proto_rawDescGZIP(), []int{10}
 }
 
+type GetModuleSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModuleSource) Reset() {
+	*x = GetModuleSource{}
+	mi := &file_tflint_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModuleSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModuleSource) ProtoMessage() {}
+
+func (x *GetModuleSource) ProtoReflect() protoreflect.Message {
+	mi := &file_tflint_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModuleSource.ProtoReflect.Descriptor instead.
+func (*GetModuleSource) Descriptor() ([]byte, []int) {
+	return file_tflint_proto_rawDescGZIP(), []int{11}
+}
+
 type GetModuleContent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -669,7 +760,7 @@ type GetModuleContent struct {
 
 func (x *GetModuleContent) Reset() {
 	*x = GetModuleContent{}
-	mi := &file_tflint_proto_msgTypes[11]
+	mi := &file_tflint_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -681,7 +772,7 @@ func (x *GetModuleContent) String() string {
 func (*GetModuleContent) ProtoMessage() {}
 
 func (x *GetModuleContent) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[11]
+	mi := &file_tflint_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -694,7 +785,7 @@ func (x *GetModuleContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetModuleContent.ProtoReflect.Descriptor instead.
 func (*GetModuleContent) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{11}
+	return file_tflint_proto_rawDescGZIP(), []int{12}
 }
 
 type GetFile struct {
@@ -705,7 +796,7 @@ type GetFile struct {
 
 func (x *GetFile) Reset() {
 	*x = GetFile{}
-	mi := &file_tflint_proto_msgTypes[12]
+	mi := &file_tflint_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -717,7 +808,7 @@ func (x *GetFile) String() string {
 func (*GetFile) ProtoMessage() {}
 
 func (x *GetFile) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[12]
+	mi := &file_tflint_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,7 +821,7 @@ func (x *GetFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFile.ProtoReflect.Descriptor instead.
 func (*GetFile) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{12}
+	return file_tflint_proto_rawDescGZIP(), []int{13}
 }
 
 type GetFiles struct {
@@ -741,7 +832,7 @@ type GetFiles struct {
 
 func (x *GetFiles) Reset() {
 	*x = GetFiles{}
-	mi := &file_tflint_proto_msgTypes[13]
+	mi := &file_tflint_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -753,7 +844,7 @@ func (x *GetFiles) String() string {
 func (*GetFiles) ProtoMessage() {}
 
 func (x *GetFiles) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[13]
+	mi := &file_tflint_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -766,7 +857,7 @@ func (x *GetFiles) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFiles.ProtoReflect.Descriptor instead.
 func (*GetFiles) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{13}
+	return file_tflint_proto_rawDescGZIP(), []int{14}
 }
 
 type GetRuleConfigContent struct {
@@ -777,7 +868,7 @@ type GetRuleConfigContent struct {
 
 func (x *GetRuleConfigContent) Reset() {
 	*x = GetRuleConfigContent{}
-	mi := &file_tflint_proto_msgTypes[14]
+	mi := &file_tflint_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -789,7 +880,7 @@ func (x *GetRuleConfigContent) String() string {
 func (*GetRuleConfigContent) ProtoMessage() {}
 
 func (x *GetRuleConfigContent) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[14]
+	mi := &file_tflint_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -802,7 +893,7 @@ func (x *GetRuleConfigContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRuleConfigContent.ProtoReflect.Descriptor instead.
 func (*GetRuleConfigContent) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{14}
+	return file_tflint_proto_rawDescGZIP(), []int{15}
 }
 
 type EvaluateExpr struct {
@@ -813,7 +904,7 @@ type EvaluateExpr struct {
 
 func (x *EvaluateExpr) Reset() {
 	*x = EvaluateExpr{}
-	mi := &file_tflint_proto_msgTypes[15]
+	mi := &file_tflint_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -825,7 +916,7 @@ func (x *EvaluateExpr) String() string {
 func (*EvaluateExpr) ProtoMessage() {}
 
 func (x *EvaluateExpr) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[15]
+	mi := &file_tflint_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -838,7 +929,43 @@ func (x *EvaluateExpr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvaluateExpr.ProtoReflect.Descriptor instead.
 func (*EvaluateExpr) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{15}
+	return file_tflint_proto_rawDescGZIP(), []int{16}
+}
+
+type EvaluateExprs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateExprs) Reset() {
+	*x = EvaluateExprs{}
+	mi := &file_tflint_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateExprs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateExprs) ProtoMessage() {}
+
+func (x *EvaluateExprs) ProtoReflect() protoreflect.Message {
+	mi := &file_tflint_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateExprs.ProtoReflect.Descriptor instead.
+func (*EvaluateExprs) Descriptor() ([]byte, []int) {
+	return file_tflint_proto_rawDescGZIP(), []int{17}
 }
 
 type EmitIssue struct {
@@ -849,7 +976,7 @@ type EmitIssue struct {
 
 func (x *EmitIssue) Reset() {
 	*x = EmitIssue{}
-	mi := &file_tflint_proto_msgTypes[16]
+	mi := &file_tflint_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -861,7 +988,7 @@ func (x *EmitIssue) String() string {
 func (*EmitIssue) ProtoMessage() {}
 
 func (x *EmitIssue) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[16]
+	mi := &file_tflint_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,7 +1001,7 @@ func (x *EmitIssue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmitIssue.ProtoReflect.Descriptor instead.
 func (*EmitIssue) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{16}
+	return file_tflint_proto_rawDescGZIP(), []int{18}
 }
 
 type ApplyChanges struct {
@@ -885,7 +1012,7 @@ type ApplyChanges struct {
 
 func (x *ApplyChanges) Reset() {
 	*x = ApplyChanges{}
-	mi := &file_tflint_proto_msgTypes[17]
+	mi := &file_tflint_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -897,7 +1024,7 @@ func (x *ApplyChanges) String() string {
 func (*ApplyChanges) ProtoMessage() {}
 
 func (x *ApplyChanges) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[17]
+	mi := &file_tflint_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -910,7 +1037,7 @@ func (x *ApplyChanges) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyChanges.ProtoReflect.Descriptor instead.
 func (*ApplyChanges) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{17}
+	return file_tflint_proto_rawDescGZIP(), []int{19}
 }
 
 type BodySchema struct {
@@ -918,13 +1045,14 @@ type BodySchema struct {
 	Attributes    []*BodySchema_Attribute `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
 	Blocks        []*BodySchema_Block     `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
 	Mode          SchemaMode              `protobuf:"varint,3,opt,name=Mode,proto3,enum=proto.SchemaMode" json:"Mode,omitempty"`
+	Depth         int64                   `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BodySchema) Reset() {
 	*x = BodySchema{}
-	mi := &file_tflint_proto_msgTypes[18]
+	mi := &file_tflint_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -936,7 +1064,7 @@ func (x *BodySchema) String() string {
 func (*BodySchema) ProtoMessage() {}
 
 func (x *BodySchema) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[18]
+	mi := &file_tflint_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -949,7 +1077,7 @@ func (x *BodySchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BodySchema.ProtoReflect.Descriptor instead.
 func (*BodySchema) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{18}
+	return file_tflint_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *BodySchema) GetAttributes() []*BodySchema_Attribute {
@@ -973,6 +1101,13 @@ func (x *BodySchema) GetMode() SchemaMode {
 	return SchemaMode_SCHEMA_MODE_UNSPECIFIED
 }
 
+func (x *BodySchema) GetDepth() int64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
 type BodyContent struct {
 	state         protoimpl.MessageState            `protogen:"open.v1"`
 	Attributes    map[string]*BodyContent_Attribute `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -983,7 +1118,7 @@ type BodyContent struct {
 
 func (x *BodyContent) Reset() {
 	*x = BodyContent{}
-	mi := &file_tflint_proto_msgTypes[19]
+	mi := &file_tflint_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -995,7 +1130,7 @@ func (x *BodyContent) String() string {
 func (*BodyContent) ProtoMessage() {}
 
 func (x *BodyContent) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[19]
+	mi := &file_tflint_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1008,7 +1143,7 @@ func (x *BodyContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BodyContent.ProtoReflect.Descriptor instead.
 func (*BodyContent) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{19}
+	return file_tflint_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *BodyContent) GetAttributes() map[string]*BodyContent_Attribute {
@@ -1037,7 +1172,7 @@ type Expression struct {
 
 func (x *Expression) Reset() {
 	*x = Expression{}
-	mi := &file_tflint_proto_msgTypes[20]
+	mi := &file_tflint_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1049,7 +1184,7 @@ func (x *Expression) String() string {
 func (*Expression) ProtoMessage() {}
 
 func (x *Expression) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[20]
+	mi := &file_tflint_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1062,7 +1197,7 @@ func (x *Expression) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Expression.ProtoReflect.Descriptor instead.
 func (*Expression) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{20}
+	return file_tflint_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Expression) GetBytes() []byte {
@@ -1104,7 +1239,7 @@ type Range struct {
 
 func (x *Range) Reset() {
 	*x = Range{}
-	mi := &file_tflint_proto_msgTypes[21]
+	mi := &file_tflint_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1116,7 +1251,7 @@ func (x *Range) String() string {
 func (*Range) ProtoMessage() {}
 
 func (x *Range) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[21]
+	mi := &file_tflint_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1129,7 +1264,7 @@ func (x *Range) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Range.ProtoReflect.Descriptor instead.
 func (*Range) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{21}
+	return file_tflint_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Range) GetFilename() string {
@@ -1153,6 +1288,58 @@ func (x *Range) GetEnd() *Range_Pos {
 	return nil
 }
 
+type TextEdit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Range         *Range                 `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	NewText       string                 `protobuf:"bytes,2,opt,name=new_text,json=newText,proto3" json:"new_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextEdit) Reset() {
+	*x = TextEdit{}
+	mi := &file_tflint_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextEdit) ProtoMessage() {}
+
+func (x *TextEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_tflint_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextEdit.ProtoReflect.Descriptor instead.
+func (*TextEdit) Descriptor() ([]byte, []int) {
+	return file_tflint_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TextEdit) GetRange() *Range {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *TextEdit) GetNewText() string {
+	if x != nil {
+		return x.NewText
+	}
+	return ""
+}
+
 type AttributePath struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Steps         []*AttributePath_Step  `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
@@ -1162,7 +1349,7 @@ type AttributePath struct {
 
 func (x *AttributePath) Reset() {
 	*x = AttributePath{}
-	mi := &file_tflint_proto_msgTypes[22]
+	mi := &file_tflint_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1174,7 +1361,7 @@ func (x *AttributePath) String() string {
 func (*AttributePath) ProtoMessage() {}
 
 func (x *AttributePath) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[22]
+	mi := &file_tflint_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1187,7 +1374,7 @@ func (x *AttributePath) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttributePath.ProtoReflect.Descriptor instead.
 func (*AttributePath) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{22}
+	return file_tflint_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *AttributePath) GetSteps() []*AttributePath_Step {
@@ -1208,7 +1395,7 @@ type ValueMark struct {
 
 func (x *ValueMark) Reset() {
 	*x = ValueMark{}
-	mi := &file_tflint_proto_msgTypes[23]
+	mi := &file_tflint_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1220,7 +1407,7 @@ func (x *ValueMark) String() string {
 func (*ValueMark) ProtoMessage() {}
 
 func (x *ValueMark) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[23]
+	mi := &file_tflint_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1233,7 +1420,7 @@ func (x *ValueMark) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueMark.ProtoReflect.Descriptor instead.
 func (*ValueMark) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{23}
+	return file_tflint_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ValueMark) GetPath() *AttributePath {
@@ -1267,7 +1454,7 @@ type ErrorDetail struct {
 
 func (x *ErrorDetail) Reset() {
 	*x = ErrorDetail{}
-	mi := &file_tflint_proto_msgTypes[24]
+	mi := &file_tflint_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1279,7 +1466,7 @@ func (x *ErrorDetail) String() string {
 func (*ErrorDetail) ProtoMessage() {}
 
 func (x *ErrorDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[24]
+	mi := &file_tflint_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1292,7 +1479,7 @@ func (x *ErrorDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrorDetail.ProtoReflect.Descriptor instead.
 func (*ErrorDetail) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{24}
+	return file_tflint_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ErrorDetail) GetCode() ErrorCode {
@@ -1317,7 +1504,7 @@ type GetName_Request struct {
 
 func (x *GetName_Request) Reset() {
 	*x = GetName_Request{}
-	mi := &file_tflint_proto_msgTypes[25]
+	mi := &file_tflint_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1329,7 +1516,7 @@ func (x *GetName_Request) String() string {
 func (*GetName_Request) ProtoMessage() {}
 
 func (x *GetName_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[25]
+	mi := &file_tflint_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1354,7 +1541,7 @@ type GetName_Response struct {
 
 func (x *GetName_Response) Reset() {
 	*x = GetName_Response{}
-	mi := &file_tflint_proto_msgTypes[26]
+	mi := &file_tflint_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1366,7 +1553,7 @@ func (x *GetName_Response) String() string {
 func (*GetName_Response) ProtoMessage() {}
 
 func (x *GetName_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[26]
+	mi := &file_tflint_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1397,7 +1584,7 @@ type GetVersion_Request struct {
 
 func (x *GetVersion_Request) Reset() {
 	*x = GetVersion_Request{}
-	mi := &file_tflint_proto_msgTypes[27]
+	mi := &file_tflint_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1409,7 +1596,7 @@ func (x *GetVersion_Request) String() string {
 func (*GetVersion_Request) ProtoMessage() {}
 
 func (x *GetVersion_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[27]
+	mi := &file_tflint_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1434,7 +1621,7 @@ type GetVersion_Response struct {
 
 func (x *GetVersion_Response) Reset() {
 	*x = GetVersion_Response{}
-	mi := &file_tflint_proto_msgTypes[28]
+	mi := &file_tflint_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1446,7 +1633,7 @@ func (x *GetVersion_Response) String() string {
 func (*GetVersion_Response) ProtoMessage() {}
 
 func (x *GetVersion_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[28]
+	mi := &file_tflint_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1477,7 +1664,7 @@ type GetVersionConstraint_Request struct {
 
 func (x *GetVersionConstraint_Request) Reset() {
 	*x = GetVersionConstraint_Request{}
-	mi := &file_tflint_proto_msgTypes[29]
+	mi := &file_tflint_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1489,7 +1676,7 @@ func (x *GetVersionConstraint_Request) String() string {
 func (*GetVersionConstraint_Request) ProtoMessage() {}
 
 func (x *GetVersionConstraint_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[29]
+	mi := &file_tflint_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,7 +1701,7 @@ type GetVersionConstraint_Response struct {
 
 func (x *GetVersionConstraint_Response) Reset() {
 	*x = GetVersionConstraint_Response{}
-	mi := &file_tflint_proto_msgTypes[30]
+	mi := &file_tflint_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1526,7 +1713,7 @@ func (x *GetVersionConstraint_Response) String() string {
 func (*GetVersionConstraint_Response) ProtoMessage() {}
 
 func (x *GetVersionConstraint_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[30]
+	mi := &file_tflint_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1557,7 +1744,7 @@ type GetSDKVersion_Request struct {
 
 func (x *GetSDKVersion_Request) Reset() {
 	*x = GetSDKVersion_Request{}
-	mi := &file_tflint_proto_msgTypes[31]
+	mi := &file_tflint_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1569,7 +1756,7 @@ func (x *GetSDKVersion_Request) String() string {
 func (*GetSDKVersion_Request) ProtoMessage() {}
 
 func (x *GetSDKVersion_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[31]
+	mi := &file_tflint_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1594,7 +1781,7 @@ type GetSDKVersion_Response struct {
 
 func (x *GetSDKVersion_Response) Reset() {
 	*x = GetSDKVersion_Response{}
-	mi := &file_tflint_proto_msgTypes[32]
+	mi := &file_tflint_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1606,7 +1793,7 @@ func (x *GetSDKVersion_Response) String() string {
 func (*GetSDKVersion_Response) ProtoMessage() {}
 
 func (x *GetSDKVersion_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[32]
+	mi := &file_tflint_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1637,7 +1824,7 @@ type GetRuleNames_Request struct {
 
 func (x *GetRuleNames_Request) Reset() {
 	*x = GetRuleNames_Request{}
-	mi := &file_tflint_proto_msgTypes[33]
+	mi := &file_tflint_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1649,7 +1836,7 @@ func (x *GetRuleNames_Request) String() string {
 func (*GetRuleNames_Request) ProtoMessage() {}
 
 func (x *GetRuleNames_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[33]
+	mi := &file_tflint_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1674,7 +1861,7 @@ type GetRuleNames_Response struct {
 
 func (x *GetRuleNames_Response) Reset() {
 	*x = GetRuleNames_Response{}
-	mi := &file_tflint_proto_msgTypes[34]
+	mi := &file_tflint_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1686,7 +1873,7 @@ func (x *GetRuleNames_Response) String() string {
 func (*GetRuleNames_Response) ProtoMessage() {}
 
 func (x *GetRuleNames_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[34]
+	mi := &file_tflint_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1717,7 +1904,7 @@ type GetConfigSchema_Request struct {
 
 func (x *GetConfigSchema_Request) Reset() {
 	*x = GetConfigSchema_Request{}
-	mi := &file_tflint_proto_msgTypes[35]
+	mi := &file_tflint_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1729,7 +1916,7 @@ func (x *GetConfigSchema_Request) String() string {
 func (*GetConfigSchema_Request) ProtoMessage() {}
 
 func (x *GetConfigSchema_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[35]
+	mi := &file_tflint_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1754,7 +1941,7 @@ type GetConfigSchema_Response struct {
 
 func (x *GetConfigSchema_Response) Reset() {
 	*x = GetConfigSchema_Response{}
-	mi := &file_tflint_proto_msgTypes[36]
+	mi := &file_tflint_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1766,7 +1953,7 @@ func (x *GetConfigSchema_Response) String() string {
 func (*GetConfigSchema_Response) ProtoMessage() {}
 
 func (x *GetConfigSchema_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[36]
+	mi := &file_tflint_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1795,13 +1982,15 @@ type ApplyGlobalConfig_Config struct {
 	DisabledByDefault bool                                     `protobuf:"varint,2,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
 	Only              []string                                 `protobuf:"bytes,3,rep,name=only,proto3" json:"only,omitempty"`
 	Fix               bool                                     `protobuf:"varint,4,opt,name=fix,proto3" json:"fix,omitempty"`
+	FormatMode        ApplyGlobalConfig_FormatMode             `protobuf:"varint,5,opt,name=format_mode,json=formatMode,proto3,enum=proto.ApplyGlobalConfig_FormatMode" json:"format_mode,omitempty"`
+	LogLevel          string                                   `protobuf:"bytes,6,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ApplyGlobalConfig_Config) Reset() {
 	*x = ApplyGlobalConfig_Config{}
-	mi := &file_tflint_proto_msgTypes[37]
+	mi := &file_tflint_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1813,7 +2002,7 @@ func (x *ApplyGlobalConfig_Config) String() string {
 func (*ApplyGlobalConfig_Config) ProtoMessage() {}
 
 func (x *ApplyGlobalConfig_Config) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[37]
+	mi := &file_tflint_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1857,6 +2046,20 @@ func (x *ApplyGlobalConfig_Config) GetFix() bool {
 	return false
 }
 
+func (x *ApplyGlobalConfig_Config) GetFormatMode() ApplyGlobalConfig_FormatMode {
+	if x != nil {
+		return x.FormatMode
+	}
+	return ApplyGlobalConfig_FORMAT_MODE_UNSPECIFIED
+}
+
+func (x *ApplyGlobalConfig_Config) GetLogLevel() string {
+	if x != nil {
+		return x.LogLevel
+	}
+	return ""
+}
+
 type ApplyGlobalConfig_RuleConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -1867,7 +2070,7 @@ type ApplyGlobalConfig_RuleConfig struct {
 
 func (x *ApplyGlobalConfig_RuleConfig) Reset() {
 	*x = ApplyGlobalConfig_RuleConfig{}
-	mi := &file_tflint_proto_msgTypes[38]
+	mi := &file_tflint_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1879,7 +2082,7 @@ func (x *ApplyGlobalConfig_RuleConfig) String() string {
 func (*ApplyGlobalConfig_RuleConfig) ProtoMessage() {}
 
 func (x *ApplyGlobalConfig_RuleConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[38]
+	mi := &file_tflint_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1918,7 +2121,7 @@ type ApplyGlobalConfig_Request struct {
 
 func (x *ApplyGlobalConfig_Request) Reset() {
 	*x = ApplyGlobalConfig_Request{}
-	mi := &file_tflint_proto_msgTypes[39]
+	mi := &file_tflint_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1930,7 +2133,7 @@ func (x *ApplyGlobalConfig_Request) String() string {
 func (*ApplyGlobalConfig_Request) ProtoMessage() {}
 
 func (x *ApplyGlobalConfig_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[39]
+	mi := &file_tflint_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1961,7 +2164,7 @@ type ApplyGlobalConfig_Response struct {
 
 func (x *ApplyGlobalConfig_Response) Reset() {
 	*x = ApplyGlobalConfig_Response{}
-	mi := &file_tflint_proto_msgTypes[40]
+	mi := &file_tflint_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1973,7 +2176,7 @@ func (x *ApplyGlobalConfig_Response) String() string {
 func (*ApplyGlobalConfig_Response) ProtoMessage() {}
 
 func (x *ApplyGlobalConfig_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[40]
+	mi := &file_tflint_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1998,7 +2201,7 @@ type ApplyConfig_Request struct {
 
 func (x *ApplyConfig_Request) Reset() {
 	*x = ApplyConfig_Request{}
-	mi := &file_tflint_proto_msgTypes[42]
+	mi := &file_tflint_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2010,7 +2213,7 @@ func (x *ApplyConfig_Request) String() string {
 func (*ApplyConfig_Request) ProtoMessage() {}
 
 func (x *ApplyConfig_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[42]
+	mi := &file_tflint_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2041,7 +2244,7 @@ type ApplyConfig_Response struct {
 
 func (x *ApplyConfig_Response) Reset() {
 	*x = ApplyConfig_Response{}
-	mi := &file_tflint_proto_msgTypes[43]
+	mi := &file_tflint_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2053,7 +2256,7 @@ func (x *ApplyConfig_Response) String() string {
 func (*ApplyConfig_Response) ProtoMessage() {}
 
 func (x *ApplyConfig_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[43]
+	mi := &file_tflint_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2072,13 +2275,14 @@ func (*ApplyConfig_Response) Descriptor() ([]byte, []int) {
 type Check_Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Runner        uint32                 `protobuf:"varint,1,opt,name=runner,proto3" json:"runner,omitempty"`
+	CheckId       string                 `protobuf:"bytes,2,opt,name=check_id,json=checkId,proto3" json:"check_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Check_Request) Reset() {
 	*x = Check_Request{}
-	mi := &file_tflint_proto_msgTypes[44]
+	mi := &file_tflint_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2090,7 +2294,7 @@ func (x *Check_Request) String() string {
 func (*Check_Request) ProtoMessage() {}
 
 func (x *Check_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[44]
+	mi := &file_tflint_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2113,6 +2317,13 @@ func (x *Check_Request) GetRunner() uint32 {
 	return 0
 }
 
+func (x *Check_Request) GetCheckId() string {
+	if x != nil {
+		return x.CheckId
+	}
+	return ""
+}
+
 type Check_Response struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -2121,7 +2332,7 @@ type Check_Response struct {
 
 func (x *Check_Response) Reset() {
 	*x = Check_Response{}
-	mi := &file_tflint_proto_msgTypes[45]
+	mi := &file_tflint_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2133,7 +2344,7 @@ func (x *Check_Response) String() string {
 func (*Check_Response) ProtoMessage() {}
 
 func (x *Check_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[45]
+	mi := &file_tflint_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2157,7 +2368,7 @@ type GetOriginalwd_Request struct {
 
 func (x *GetOriginalwd_Request) Reset() {
 	*x = GetOriginalwd_Request{}
-	mi := &file_tflint_proto_msgTypes[46]
+	mi := &file_tflint_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2169,7 +2380,7 @@ func (x *GetOriginalwd_Request) String() string {
 func (*GetOriginalwd_Request) ProtoMessage() {}
 
 func (x *GetOriginalwd_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[46]
+	mi := &file_tflint_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2194,7 +2405,7 @@ type GetOriginalwd_Response struct {
 
 func (x *GetOriginalwd_Response) Reset() {
 	*x = GetOriginalwd_Response{}
-	mi := &file_tflint_proto_msgTypes[47]
+	mi := &file_tflint_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2206,7 +2417,7 @@ func (x *GetOriginalwd_Response) String() string {
 func (*GetOriginalwd_Response) ProtoMessage() {}
 
 func (x *GetOriginalwd_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[47]
+	mi := &file_tflint_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2237,7 +2448,7 @@ type GetModulePath_Request struct {
 
 func (x *GetModulePath_Request) Reset() {
 	*x = GetModulePath_Request{}
-	mi := &file_tflint_proto_msgTypes[48]
+	mi := &file_tflint_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2249,7 +2460,7 @@ func (x *GetModulePath_Request) String() string {
 func (*GetModulePath_Request) ProtoMessage() {}
 
 func (x *GetModulePath_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[48]
+	mi := &file_tflint_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2274,7 +2485,7 @@ type GetModulePath_Response struct {
 
 func (x *GetModulePath_Response) Reset() {
 	*x = GetModulePath_Response{}
-	mi := &file_tflint_proto_msgTypes[49]
+	mi := &file_tflint_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2286,7 +2497,7 @@ func (x *GetModulePath_Response) String() string {
 func (*GetModulePath_Response) ProtoMessage() {}
 
 func (x *GetModulePath_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[49]
+	mi := &file_tflint_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2309,6 +2520,94 @@ func (x *GetModulePath_Response) GetPath() []string {
 	return nil
 }
 
+type GetModuleSource_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModuleSource_Request) Reset() {
+	*x = GetModuleSource_Request{}
+	mi := &file_tflint_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModuleSource_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModuleSource_Request) ProtoMessage() {}
+
+func (x *GetModuleSource_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_tflint_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModuleSource_Request.ProtoReflect.Descriptor instead.
+func (*GetModuleSource_Request) Descriptor() ([]byte, []int) {
+	return file_tflint_proto_rawDescGZIP(), []int{11, 0}
+}
+
+type GetModuleSource_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dir           string                 `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	Local         bool                   `protobuf:"varint,2,opt,name=local,proto3" json:"local,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModuleSource_Response) Reset() {
+	*x = GetModuleSource_Response{}
+	mi := &file_tflint_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModuleSource_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModuleSource_Response) ProtoMessage() {}
+
+func (x *GetModuleSource_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_tflint_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModuleSource_Response.ProtoReflect.Descriptor instead.
+func (*GetModuleSource_Response) Descriptor() ([]byte, []int) {
+	return file_tflint_proto_rawDescGZIP(), []int{11, 1}
+}
+
+func (x *GetModuleSource_Response) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+func (x *GetModuleSource_Response) GetLocal() bool {
+	if x != nil {
+		return x.Local
+	}
+	return false
+}
+
 type GetModuleContent_Hint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ResourceType  string                 `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
@@ -2318,7 +2617,7 @@ type GetModuleContent_Hint struct {
 
 func (x *GetModuleContent_Hint) Reset() {
 	*x = GetModuleContent_Hint{}
-	mi := &file_tflint_proto_msgTypes[50]
+	mi := &file_tflint_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2330,7 +2629,7 @@ func (x *GetModuleContent_Hint) String() string {
 func (*GetModuleContent_Hint) ProtoMessage() {}
 
 func (x *GetModuleContent_Hint) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[50]
+	mi := &file_tflint_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2343,7 +2642,7 @@ func (x *GetModuleContent_Hint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetModuleContent_Hint.ProtoReflect.Descriptor instead.
 func (*GetModuleContent_Hint) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{11, 0}
+	return file_tflint_proto_rawDescGZIP(), []int{12, 0}
 }
 
 func (x *GetModuleContent_Hint) GetResourceType() string {
@@ -2364,7 +2663,7 @@ type GetModuleContent_Option struct {
 
 func (x *GetModuleContent_Option) Reset() {
 	*x = GetModuleContent_Option{}
-	mi := &file_tflint_proto_msgTypes[51]
+	mi := &file_tflint_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2376,7 +2675,7 @@ func (x *GetModuleContent_Option) String() string {
 func (*GetModuleContent_Option) ProtoMessage() {}
 
 func (x *GetModuleContent_Option) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[51]
+	mi := &file_tflint_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2389,7 +2688,7 @@ func (x *GetModuleContent_Option) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetModuleContent_Option.ProtoReflect.Descriptor instead.
 func (*GetModuleContent_Option) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{11, 1}
+	return file_tflint_proto_rawDescGZIP(), []int{12, 1}
 }
 
 func (x *GetModuleContent_Option) GetModuleCtx() ModuleCtxType {
@@ -2423,7 +2722,7 @@ type GetModuleContent_Request struct {
 
 func (x *GetModuleContent_Request) Reset() {
 	*x = GetModuleContent_Request{}
-	mi := &file_tflint_proto_msgTypes[52]
+	mi := &file_tflint_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2435,7 +2734,7 @@ func (x *GetModuleContent_Request) String() string {
 func (*GetModuleContent_Request) ProtoMessage() {}
 
 func (x *GetModuleContent_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[52]
+	mi := &file_tflint_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2448,7 +2747,7 @@ func (x *GetModuleContent_Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetModuleContent_Request.ProtoReflect.Descriptor instead.
 func (*GetModuleContent_Request) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{11, 2}
+	return file_tflint_proto_rawDescGZIP(), []int{12, 2}
 }
 
 func (x *GetModuleContent_Request) GetSchema() *BodySchema {
@@ -2474,7 +2773,7 @@ type GetModuleContent_Response struct {
 
 func (x *GetModuleContent_Response) Reset() {
 	*x = GetModuleContent_Response{}
-	mi := &file_tflint_proto_msgTypes[53]
+	mi := &file_tflint_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2486,7 +2785,7 @@ func (x *GetModuleContent_Response) String() string {
 func (*GetModuleContent_Response) ProtoMessage() {}
 
 func (x *GetModuleContent_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[53]
+	mi := &file_tflint_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2499,7 +2798,7 @@ func (x *GetModuleContent_Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetModuleContent_Response.ProtoReflect.Descriptor instead.
 func (*GetModuleContent_Response) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{11, 3}
+	return file_tflint_proto_rawDescGZIP(), []int{12, 3}
 }
 
 func (x *GetModuleContent_Response) GetContent() *BodyContent {
@@ -2518,7 +2817,7 @@ type GetFile_Request struct {
 
 func (x *GetFile_Request) Reset() {
 	*x = GetFile_Request{}
-	mi := &file_tflint_proto_msgTypes[54]
+	mi := &file_tflint_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2530,7 +2829,7 @@ func (x *GetFile_Request) String() string {
 func (*GetFile_Request) ProtoMessage() {}
 
 func (x *GetFile_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[54]
+	mi := &file_tflint_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2543,7 +2842,7 @@ func (x *GetFile_Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFile_Request.ProtoReflect.Descriptor instead.
 func (*GetFile_Request) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{12, 0}
+	return file_tflint_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *GetFile_Request) GetName() string {
@@ -2562,7 +2861,7 @@ type GetFile_Response struct {
 
 func (x *GetFile_Response) Reset() {
 	*x = GetFile_Response{}
-	mi := &file_tflint_proto_msgTypes[55]
+	mi := &file_tflint_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2574,7 +2873,7 @@ func (x *GetFile_Response) String() string {
 func (*GetFile_Response) ProtoMessage() {}
 
 func (x *GetFile_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[55]
+	mi := &file_tflint_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2587,7 +2886,7 @@ func (x *GetFile_Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFile_Response.ProtoReflect.Descriptor instead.
 func (*GetFile_Response) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{12, 1}
+	return file_tflint_proto_rawDescGZIP(), []int{13, 1}
 }
 
 func (x *GetFile_Response) GetFile() []byte {
@@ -2605,7 +2904,7 @@ type GetFiles_Request struct {
 
 func (x *GetFiles_Request) Reset() {
 	*x = GetFiles_Request{}
-	mi := &file_tflint_proto_msgTypes[56]
+	mi := &file_tflint_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2617,7 +2916,7 @@ func (x *GetFiles_Request) String() string {
 func (*GetFiles_Request) ProtoMessage() {}
 
 func (x *GetFiles_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[56]
+	mi := &file_tflint_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2630,7 +2929,7 @@ func (x *GetFiles_Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFiles_Request.ProtoReflect.Descriptor instead.
 func (*GetFiles_Request) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{13, 0}
+	return file_tflint_proto_rawDescGZIP(), []int{14, 0}
 }
 
 type GetFiles_Response struct {
@@ -2642,7 +2941,7 @@ type GetFiles_Response struct {
 
 func (x *GetFiles_Response) Reset() {
 	*x = GetFiles_Response{}
-	mi := &file_tflint_proto_msgTypes[57]
+	mi := &file_tflint_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2654,7 +2953,7 @@ func (x *GetFiles_Response) String() string {
 func (*GetFiles_Response) ProtoMessage() {}
 
 func (x *GetFiles_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[57]
+	mi := &file_tflint_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2667,7 +2966,7 @@ func (x *GetFiles_Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFiles_Response.ProtoReflect.Descriptor instead.
 func (*GetFiles_Response) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{13, 1}
+	return file_tflint_proto_rawDescGZIP(), []int{14, 1}
 }
 
 func (x *GetFiles_Response) GetFiles() map[string][]byte {
@@ -2687,7 +2986,7 @@ type GetRuleConfigContent_Request struct {
 
 func (x *GetRuleConfigContent_Request) Reset() {
 	*x = GetRuleConfigContent_Request{}
-	mi := &file_tflint_proto_msgTypes[59]
+	mi := &file_tflint_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2699,7 +2998,7 @@ func (x *GetRuleConfigContent_Request) String() string {
 func (*GetRuleConfigContent_Request) ProtoMessage() {}
 
 func (x *GetRuleConfigContent_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[59]
+	mi := &file_tflint_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2712,7 +3011,7 @@ func (x *GetRuleConfigContent_Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRuleConfigContent_Request.ProtoReflect.Descriptor instead.
 func (*GetRuleConfigContent_Request) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{14, 0}
+	return file_tflint_proto_rawDescGZIP(), []int{15, 0}
 }
 
 func (x *GetRuleConfigContent_Request) GetName() string {
@@ -2738,7 +3037,7 @@ type GetRuleConfigContent_Response struct {
 
 func (x *GetRuleConfigContent_Response) Reset() {
 	*x = GetRuleConfigContent_Response{}
-	mi := &file_tflint_proto_msgTypes[60]
+	mi := &file_tflint_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2750,7 +3049,7 @@ func (x *GetRuleConfigContent_Response) String() string {
 func (*GetRuleConfigContent_Response) ProtoMessage() {}
 
 func (x *GetRuleConfigContent_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[60]
+	mi := &file_tflint_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2763,7 +3062,7 @@ func (x *GetRuleConfigContent_Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRuleConfigContent_Response.ProtoReflect.Descriptor instead.
 func (*GetRuleConfigContent_Response) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{14, 1}
+	return file_tflint_proto_rawDescGZIP(), []int{15, 1}
 }
 
 func (x *GetRuleConfigContent_Response) GetContent() *BodyContent {
@@ -2783,7 +3082,7 @@ type EvaluateExpr_Option struct {
 
 func (x *EvaluateExpr_Option) Reset() {
 	*x = EvaluateExpr_Option{}
-	mi := &file_tflint_proto_msgTypes[61]
+	mi := &file_tflint_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2795,7 +3094,7 @@ func (x *EvaluateExpr_Option) String() string {
 func (*EvaluateExpr_Option) ProtoMessage() {}
 
 func (x *EvaluateExpr_Option) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[61]
+	mi := &file_tflint_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2808,7 +3107,7 @@ func (x *EvaluateExpr_Option) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvaluateExpr_Option.ProtoReflect.Descriptor instead.
 func (*EvaluateExpr_Option) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{15, 0}
+	return file_tflint_proto_rawDescGZIP(), []int{16, 0}
 }
 
 func (x *EvaluateExpr_Option) GetType() []byte {
@@ -2835,7 +3134,7 @@ type EvaluateExpr_Request struct {
 
 func (x *EvaluateExpr_Request) Reset() {
 	*x = EvaluateExpr_Request{}
-	mi := &file_tflint_proto_msgTypes[62]
+	mi := &file_tflint_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2847,7 +3146,7 @@ func (x *EvaluateExpr_Request) String() string {
 func (*EvaluateExpr_Request) ProtoMessage() {}
 
 func (x *EvaluateExpr_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[62]
+	mi := &file_tflint_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2860,7 +3159,7 @@ func (x *EvaluateExpr_Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvaluateExpr_Request.ProtoReflect.Descriptor instead.
 func (*EvaluateExpr_Request) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{15, 1}
+	return file_tflint_proto_rawDescGZIP(), []int{16, 1}
 }
 
 func (x *EvaluateExpr_Request) GetOption() *EvaluateExpr_Option {
@@ -2887,7 +3186,7 @@ type EvaluateExpr_Response struct {
 
 func (x *EvaluateExpr_Response) Reset() {
 	*x = EvaluateExpr_Response{}
-	mi := &file_tflint_proto_msgTypes[63]
+	mi := &file_tflint_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2899,7 +3198,7 @@ func (x *EvaluateExpr_Response) String() string {
 func (*EvaluateExpr_Response) ProtoMessage() {}
 
 func (x *EvaluateExpr_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[63]
+	mi := &file_tflint_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2912,7 +3211,7 @@ func (x *EvaluateExpr_Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvaluateExpr_Response.ProtoReflect.Descriptor instead.
 func (*EvaluateExpr_Response) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{15, 2}
+	return file_tflint_proto_rawDescGZIP(), []int{16, 2}
 }
 
 func (x *EvaluateExpr_Response) GetValue() []byte {
@@ -2929,6 +3228,146 @@ func (x *EvaluateExpr_Response) GetMarks() []*ValueMark {
 	return nil
 }
 
+type EvaluateExprs_Result struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *EvaluateExpr_Response `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Error         *ErrorDetail           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateExprs_Result) Reset() {
+	*x = EvaluateExprs_Result{}
+	mi := &file_tflint_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateExprs_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateExprs_Result) ProtoMessage() {}
+
+func (x *EvaluateExprs_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_tflint_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateExprs_Result.ProtoReflect.Descriptor instead.
+func (*EvaluateExprs_Result) Descriptor() ([]byte, []int) {
+	return file_tflint_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *EvaluateExprs_Result) GetResponse() *EvaluateExpr_Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *EvaluateExprs_Result) GetError() *ErrorDetail {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type EvaluateExprs_Request struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Requests      []*EvaluateExpr_Request `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateExprs_Request) Reset() {
+	*x = EvaluateExprs_Request{}
+	mi := &file_tflint_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateExprs_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateExprs_Request) ProtoMessage() {}
+
+func (x *EvaluateExprs_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_tflint_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateExprs_Request.ProtoReflect.Descriptor instead.
+func (*EvaluateExprs_Request) Descriptor() ([]byte, []int) {
+	return file_tflint_proto_rawDescGZIP(), []int{17, 1}
+}
+
+func (x *EvaluateExprs_Request) GetRequests() []*EvaluateExpr_Request {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type EvaluateExprs_Response struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Results       []*EvaluateExprs_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateExprs_Response) Reset() {
+	*x = EvaluateExprs_Response{}
+	mi := &file_tflint_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateExprs_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateExprs_Response) ProtoMessage() {}
+
+func (x *EvaluateExprs_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_tflint_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateExprs_Response.ProtoReflect.Descriptor instead.
+func (*EvaluateExprs_Response) Descriptor() ([]byte, []int) {
+	return file_tflint_proto_rawDescGZIP(), []int{17, 2}
+}
+
+func (x *EvaluateExprs_Response) GetResults() []*EvaluateExprs_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type EmitIssue_Rule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -2941,7 +3380,7 @@ type EmitIssue_Rule struct {
 
 func (x *EmitIssue_Rule) Reset() {
 	*x = EmitIssue_Rule{}
-	mi := &file_tflint_proto_msgTypes[64]
+	mi := &file_tflint_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2953,7 +3392,7 @@ func (x *EmitIssue_Rule) String() string {
 func (*EmitIssue_Rule) ProtoMessage() {}
 
 func (x *EmitIssue_Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[64]
+	mi := &file_tflint_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2966,7 +3405,7 @@ func (x *EmitIssue_Rule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmitIssue_Rule.ProtoReflect.Descriptor instead.
 func (*EmitIssue_Rule) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{16, 0}
+	return file_tflint_proto_rawDescGZIP(), []int{18, 0}
 }
 
 func (x *EmitIssue_Rule) GetName() string {
@@ -2997,19 +3436,88 @@ func (x *EmitIssue_Rule) GetLink() string {
 	return ""
 }
 
+type EmitIssue_Fix struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Changes       map[string][]byte      `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Edits         []*TextEdit            `protobuf:"bytes,3,rep,name=edits,proto3" json:"edits,omitempty"`
+	Conflict      string                 `protobuf:"bytes,4,opt,name=conflict,proto3" json:"conflict,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmitIssue_Fix) Reset() {
+	*x = EmitIssue_Fix{}
+	mi := &file_tflint_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmitIssue_Fix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmitIssue_Fix) ProtoMessage() {}
+
+func (x *EmitIssue_Fix) ProtoReflect() protoreflect.Message {
+	mi := &file_tflint_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmitIssue_Fix.ProtoReflect.Descriptor instead.
+func (*EmitIssue_Fix) Descriptor() ([]byte, []int) {
+	return file_tflint_proto_rawDescGZIP(), []int{18, 1}
+}
+
+func (x *EmitIssue_Fix) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EmitIssue_Fix) GetChanges() map[string][]byte {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *EmitIssue_Fix) GetEdits() []*TextEdit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+func (x *EmitIssue_Fix) GetConflict() string {
+	if x != nil {
+		return x.Conflict
+	}
+	return ""
+}
+
 type EmitIssue_Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Rule          *EmitIssue_Rule        `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Range         *Range                 `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
 	Fixable       bool                   `protobuf:"varint,4,opt,name=fixable,proto3" json:"fixable,omitempty"`
+	Fixes         []*EmitIssue_Fix       `protobuf:"bytes,5,rep,name=fixes,proto3" json:"fixes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EmitIssue_Request) Reset() {
 	*x = EmitIssue_Request{}
-	mi := &file_tflint_proto_msgTypes[65]
+	mi := &file_tflint_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3021,7 +3529,7 @@ func (x *EmitIssue_Request) String() string {
 func (*EmitIssue_Request) ProtoMessage() {}
 
 func (x *EmitIssue_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[65]
+	mi := &file_tflint_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3034,7 +3542,7 @@ func (x *EmitIssue_Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmitIssue_Request.ProtoReflect.Descriptor instead.
 func (*EmitIssue_Request) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{16, 1}
+	return file_tflint_proto_rawDescGZIP(), []int{18, 2}
 }
 
 func (x *EmitIssue_Request) GetRule() *EmitIssue_Rule {
@@ -3065,6 +3573,13 @@ func (x *EmitIssue_Request) GetFixable() bool {
 	return false
 }
 
+func (x *EmitIssue_Request) GetFixes() []*EmitIssue_Fix {
+	if x != nil {
+		return x.Fixes
+	}
+	return nil
+}
+
 type EmitIssue_Response struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Applied       bool                   `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
@@ -3074,7 +3589,7 @@ type EmitIssue_Response struct {
 
 func (x *EmitIssue_Response) Reset() {
 	*x = EmitIssue_Response{}
-	mi := &file_tflint_proto_msgTypes[66]
+	mi := &file_tflint_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3086,7 +3601,7 @@ func (x *EmitIssue_Response) String() string {
 func (*EmitIssue_Response) ProtoMessage() {}
 
 func (x *EmitIssue_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[66]
+	mi := &file_tflint_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3099,7 +3614,7 @@ func (x *EmitIssue_Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmitIssue_Response.ProtoReflect.Descriptor instead.
 func (*EmitIssue_Response) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{16, 2}
+	return file_tflint_proto_rawDescGZIP(), []int{18, 3}
 }
 
 func (x *EmitIssue_Response) GetApplied() bool {
@@ -3112,13 +3627,14 @@ func (x *EmitIssue_Response) GetApplied() bool {
 type ApplyChanges_Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Changes       map[string][]byte      `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Edits         []*TextEdit            `protobuf:"bytes,2,rep,name=edits,proto3" json:"edits,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ApplyChanges_Request) Reset() {
 	*x = ApplyChanges_Request{}
-	mi := &file_tflint_proto_msgTypes[67]
+	mi := &file_tflint_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3130,7 +3646,7 @@ func (x *ApplyChanges_Request) String() string {
 func (*ApplyChanges_Request) ProtoMessage() {}
 
 func (x *ApplyChanges_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[67]
+	mi := &file_tflint_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3143,7 +3659,7 @@ func (x *ApplyChanges_Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyChanges_Request.ProtoReflect.Descriptor instead.
 func (*ApplyChanges_Request) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{17, 0}
+	return file_tflint_proto_rawDescGZIP(), []int{19, 0}
 }
 
 func (x *ApplyChanges_Request) GetChanges() map[string][]byte {
@@ -3153,6 +3669,13 @@ func (x *ApplyChanges_Request) GetChanges() map[string][]byte {
 	return nil
 }
 
+func (x *ApplyChanges_Request) GetEdits() []*TextEdit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
 type ApplyChanges_Response struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -3161,7 +3684,7 @@ type ApplyChanges_Response struct {
 
 func (x *ApplyChanges_Response) Reset() {
 	*x = ApplyChanges_Response{}
-	mi := &file_tflint_proto_msgTypes[68]
+	mi := &file_tflint_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3173,7 +3696,7 @@ func (x *ApplyChanges_Response) String() string {
 func (*ApplyChanges_Response) ProtoMessage() {}
 
 func (x *ApplyChanges_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[68]
+	mi := &file_tflint_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3186,20 +3709,22 @@ func (x *ApplyChanges_Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyChanges_Response.ProtoReflect.Descriptor instead.
 func (*ApplyChanges_Response) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{17, 1}
+	return file_tflint_proto_rawDescGZIP(), []int{19, 1}
 }
 
 type BodySchema_Attribute struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Required      bool                   `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	Type          []byte                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	LiteralOnly   bool                   `protobuf:"varint,4,opt,name=literal_only,json=literalOnly,proto3" json:"literal_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BodySchema_Attribute) Reset() {
 	*x = BodySchema_Attribute{}
-	mi := &file_tflint_proto_msgTypes[70]
+	mi := &file_tflint_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3211,7 +3736,7 @@ func (x *BodySchema_Attribute) String() string {
 func (*BodySchema_Attribute) ProtoMessage() {}
 
 func (x *BodySchema_Attribute) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[70]
+	mi := &file_tflint_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3224,7 +3749,7 @@ func (x *BodySchema_Attribute) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BodySchema_Attribute.ProtoReflect.Descriptor instead.
 func (*BodySchema_Attribute) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{18, 0}
+	return file_tflint_proto_rawDescGZIP(), []int{20, 0}
 }
 
 func (x *BodySchema_Attribute) GetName() string {
@@ -3241,6 +3766,20 @@ func (x *BodySchema_Attribute) GetRequired() bool {
 	return false
 }
 
+func (x *BodySchema_Attribute) GetType() []byte {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *BodySchema_Attribute) GetLiteralOnly() bool {
+	if x != nil {
+		return x.LiteralOnly
+	}
+	return false
+}
+
 type BodySchema_Block struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
@@ -3252,7 +3791,7 @@ type BodySchema_Block struct {
 
 func (x *BodySchema_Block) Reset() {
 	*x = BodySchema_Block{}
-	mi := &file_tflint_proto_msgTypes[71]
+	mi := &file_tflint_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3264,7 +3803,7 @@ func (x *BodySchema_Block) String() string {
 func (*BodySchema_Block) ProtoMessage() {}
 
 func (x *BodySchema_Block) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[71]
+	mi := &file_tflint_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3277,7 +3816,7 @@ func (x *BodySchema_Block) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BodySchema_Block.ProtoReflect.Descriptor instead.
 func (*BodySchema_Block) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{18, 1}
+	return file_tflint_proto_rawDescGZIP(), []int{20, 1}
 }
 
 func (x *BodySchema_Block) GetType() string {
@@ -3313,7 +3852,7 @@ type BodyContent_Attribute struct {
 
 func (x *BodyContent_Attribute) Reset() {
 	*x = BodyContent_Attribute{}
-	mi := &file_tflint_proto_msgTypes[72]
+	mi := &file_tflint_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3325,7 +3864,7 @@ func (x *BodyContent_Attribute) String() string {
 func (*BodyContent_Attribute) ProtoMessage() {}
 
 func (x *BodyContent_Attribute) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[72]
+	mi := &file_tflint_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3338,7 +3877,7 @@ func (x *BodyContent_Attribute) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BodyContent_Attribute.ProtoReflect.Descriptor instead.
 func (*BodyContent_Attribute) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{19, 0}
+	return file_tflint_proto_rawDescGZIP(), []int{21, 0}
 }
 
 func (x *BodyContent_Attribute) GetName() string {
@@ -3370,20 +3909,21 @@ func (x *BodyContent_Attribute) GetExpression() *Expression {
 }
 
 type BodyContent_Block struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Labels        []string               `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
-	Body          *BodyContent           `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
-	DefRange      *Range                 `protobuf:"bytes,4,opt,name=def_range,json=defRange,proto3" json:"def_range,omitempty"`
-	TypeRange     *Range                 `protobuf:"bytes,5,opt,name=type_range,json=typeRange,proto3" json:"type_range,omitempty"`
-	LabelRanges   []*Range               `protobuf:"bytes,6,rep,name=label_ranges,json=labelRanges,proto3" json:"label_ranges,omitempty"`
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Type          string                      `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Labels        []string                    `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+	Body          *BodyContent                `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	DefRange      *Range                      `protobuf:"bytes,4,opt,name=def_range,json=defRange,proto3" json:"def_range,omitempty"`
+	TypeRange     *Range                      `protobuf:"bytes,5,opt,name=type_range,json=typeRange,proto3" json:"type_range,omitempty"`
+	LabelRanges   []*Range                    `protobuf:"bytes,6,rep,name=label_ranges,json=labelRanges,proto3" json:"label_ranges,omitempty"`
+	Instance      *BodyContent_Block_Instance `protobuf:"bytes,7,opt,name=instance,proto3" json:"instance,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BodyContent_Block) Reset() {
 	*x = BodyContent_Block{}
-	mi := &file_tflint_proto_msgTypes[73]
+	mi := &file_tflint_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3395,7 +3935,7 @@ func (x *BodyContent_Block) String() string {
 func (*BodyContent_Block) ProtoMessage() {}
 
 func (x *BodyContent_Block) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[73]
+	mi := &file_tflint_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3408,7 +3948,7 @@ func (x *BodyContent_Block) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BodyContent_Block.ProtoReflect.Descriptor instead.
 func (*BodyContent_Block) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{19, 1}
+	return file_tflint_proto_rawDescGZIP(), []int{21, 1}
 }
 
 func (x *BodyContent_Block) GetType() string {
@@ -3453,6 +3993,119 @@ func (x *BodyContent_Block) GetLabelRanges() []*Range {
 	return nil
 }
 
+func (x *BodyContent_Block) GetInstance() *BodyContent_Block_Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+type BodyContent_Block_Instance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Key:
+	//
+	//	*BodyContent_Block_Instance_IntKey
+	//	*BodyContent_Block_Instance_StringKey
+	Key           isBodyContent_Block_Instance_Key `protobuf_oneof:"key"`
+	Value         []byte                           `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ValueMarks    []*ValueMark                     `protobuf:"bytes,4,rep,name=value_marks,json=valueMarks,proto3" json:"value_marks,omitempty"`
+	Dynamic       bool                             `protobuf:"varint,5,opt,name=dynamic,proto3" json:"dynamic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BodyContent_Block_Instance) Reset() {
+	*x = BodyContent_Block_Instance{}
+	mi := &file_tflint_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BodyContent_Block_Instance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BodyContent_Block_Instance) ProtoMessage() {}
+
+func (x *BodyContent_Block_Instance) ProtoReflect() protoreflect.Message {
+	mi := &file_tflint_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BodyContent_Block_Instance.ProtoReflect.Descriptor instead.
+func (*BodyContent_Block_Instance) Descriptor() ([]byte, []int) {
+	return file_tflint_proto_rawDescGZIP(), []int{21, 1, 0}
+}
+
+func (x *BodyContent_Block_Instance) GetKey() isBodyContent_Block_Instance_Key {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *BodyContent_Block_Instance) GetIntKey() int64 {
+	if x != nil {
+		if x, ok := x.Key.(*BodyContent_Block_Instance_IntKey); ok {
+			return x.IntKey
+		}
+	}
+	return 0
+}
+
+func (x *BodyContent_Block_Instance) GetStringKey() string {
+	if x != nil {
+		if x, ok := x.Key.(*BodyContent_Block_Instance_StringKey); ok {
+			return x.StringKey
+		}
+	}
+	return ""
+}
+
+func (x *BodyContent_Block_Instance) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *BodyContent_Block_Instance) GetValueMarks() []*ValueMark {
+	if x != nil {
+		return x.ValueMarks
+	}
+	return nil
+}
+
+func (x *BodyContent_Block_Instance) GetDynamic() bool {
+	if x != nil {
+		return x.Dynamic
+	}
+	return false
+}
+
+type isBodyContent_Block_Instance_Key interface {
+	isBodyContent_Block_Instance_Key()
+}
+
+type BodyContent_Block_Instance_IntKey struct {
+	IntKey int64 `protobuf:"varint,1,opt,name=int_key,json=intKey,proto3,oneof"`
+}
+
+type BodyContent_Block_Instance_StringKey struct {
+	StringKey string `protobuf:"bytes,2,opt,name=string_key,json=stringKey,proto3,oneof"`
+}
+
+func (*BodyContent_Block_Instance_IntKey) isBodyContent_Block_Instance_Key() {}
+
+func (*BodyContent_Block_Instance_StringKey) isBodyContent_Block_Instance_Key() {}
+
 type Range_Pos struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Line          int64                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
@@ -3464,7 +4117,7 @@ type Range_Pos struct {
 
 func (x *Range_Pos) Reset() {
 	*x = Range_Pos{}
-	mi := &file_tflint_proto_msgTypes[75]
+	mi := &file_tflint_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3476,7 +4129,7 @@ func (x *Range_Pos) String() string {
 func (*Range_Pos) ProtoMessage() {}
 
 func (x *Range_Pos) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[75]
+	mi := &file_tflint_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3489,7 +4142,7 @@ func (x *Range_Pos) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Range_Pos.ProtoReflect.Descriptor instead.
 func (*Range_Pos) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{21, 0}
+	return file_tflint_proto_rawDescGZIP(), []int{23, 0}
 }
 
 func (x *Range_Pos) GetLine() int64 {
@@ -3527,7 +4180,7 @@ type AttributePath_Step struct {
 
 func (x *AttributePath_Step) Reset() {
 	*x = AttributePath_Step{}
-	mi := &file_tflint_proto_msgTypes[76]
+	mi := &file_tflint_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3539,7 +4192,7 @@ func (x *AttributePath_Step) String() string {
 func (*AttributePath_Step) ProtoMessage() {}
 
 func (x *AttributePath_Step) ProtoReflect() protoreflect.Message {
-	mi := &file_tflint_proto_msgTypes[76]
+	mi := &file_tflint_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3552,7 +4205,7 @@ func (x *AttributePath_Step) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttributePath_Step.ProtoReflect.Descriptor instead.
 func (*AttributePath_Step) Descriptor() ([]byte, []int) {
-	return file_tflint_proto_rawDescGZIP(), []int{22, 0}
+	return file_tflint_proto_rawDescGZIP(), []int{25, 0}
 }
 
 func (x *AttributePath_Step) GetSelector() isAttributePath_Step_Selector {
@@ -3640,9 +4293,9 @@ var file_tflint_proto_rawDesc = []byte{
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0xa1, 0x03,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0xfb, 0x04,
 	0x0a, 0x11, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x1a, 0xff, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x40,
+	0x66, 0x69, 0x67, 0x1a, 0xe2, 0x02, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x40,
 	0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61,
 	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52,
@@ -3652,351 +4305,443 @@ var file_tflint_proto_rawDesc = []byte{
 	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
 	0x12, 0x12, 0x0a, 0x04, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
 	0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x03, 0x66, 0x69, 0x78, 0x1a, 0x5d, 0x0a, 0x0a, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70,
-	0x70, 0x6c, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0a, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x1a, 0x42, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x52, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x1a, 0x37, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x1a, 0x21,
-	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x75, 0x6e,
-	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x72, 0x75, 0x6e, 0x6e, 0x65,
-	0x72, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x77, 0x64, 0x1a, 0x09,
-	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x0a, 0x08, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3a, 0x0a, 0x0d, 0x47, 0x65, 0x74,
-	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xfd, 0x03, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x2b, 0x0a, 0x04, 0x48, 0x69,
-	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x1a, 0xba, 0x01, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x63, 0x74, 0x78,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x43, 0x74, 0x78, 0x12, 0x30, 0x0a, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
-	0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x48,
-	0x69, 0x6e, 0x74, 0x52, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0b, 0x65, 0x78, 0x70,
-	0x61, 0x6e, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x4d, 0x6f,
-	0x64, 0x65, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x4a, 0x04,
-	0x08, 0x03, 0x10, 0x04, 0x1a, 0x6c, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x29, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x36, 0x0a, 0x06, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x38, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x08, 0x52, 0x03, 0x66, 0x69, 0x78, 0x12, 0x44, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x0a, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x1a, 0x5d, 0x0a, 0x0a, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0a, 0x52, 0x75, 0x6c, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x1a, 0x42, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x37, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x0a, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x14, 0x0a, 0x10, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x46,
+	0x49, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x5f, 0x42, 0x4c, 0x4f,
+	0x43, 0x4b, 0x53, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x22, 0x52, 0x0a, 0x0b, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x37, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x6f, 0x64, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x51, 0x0a, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x1a, 0x3c, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x49, 0x64, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x77, 0x64, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3a,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x1a,
+	0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x50, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x09, 0x0a,
+	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x64, 0x69, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x22, 0xfd, 0x03, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x1a, 0x2b, 0x0a, 0x04, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x1a, 0xba,
+	0x01, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0a, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x5f, 0x63, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x74, 0x78, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x74, 0x78, 0x12, 0x30,
+	0x0a, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x68, 0x69, 0x6e, 0x74,
+	0x12, 0x43, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x45,
+	0x78, 0x70, 0x61, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x61, 0x6e,
+	0x64, 0x4d, 0x6f, 0x64, 0x65, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x1a, 0x6c, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x6f, 0x64, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x12, 0x36, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x38, 0x0a, 0x08, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x6f, 0x64, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x22, 0x57, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x58, 0x50, 0x41, 0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14,
+	0x0a, 0x10, 0x45, 0x58, 0x50, 0x41, 0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x41, 0x4e, 0x44, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x41, 0x4e, 0x44, 0x10, 0x02, 0x22, 0x48, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x1d, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x1e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x7f,
+	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x9a, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x48, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x42, 0x6f, 0x64, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x1a, 0x38, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
 	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x57, 0x0a, 0x0a,
-	0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x58,
-	0x50, 0x41, 0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x50, 0x41, 0x4e,
-	0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x16, 0x0a,
-	0x12, 0x45, 0x58, 0x50, 0x41, 0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x58, 0x50,
-	0x41, 0x4e, 0x44, 0x10, 0x02, 0x22, 0x48, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65,
-	0x1a, 0x1d, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a,
-	0x1e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66,
-	0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x22,
-	0x96, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x09, 0x0a, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x7f, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x38,
-	0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9a, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x1a, 0x48, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x29, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x1a, 0x38, 0x0a, 0x08, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x42, 0x6f, 0x64, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xa9, 0x02, 0x0a, 0x0c, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
-	0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x1a, 0x51, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x63,
-	0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x74, 0x78, 0x1a, 0x7c, 0x0a, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x61,
-	0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
+	0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xa9, 0x02, 0x0a,
+	0x0c, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x1a, 0x51, 0x0a,
+	0x06, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x63, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x74,
+	0x78, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x74, 0x78,
+	0x1a, 0x7c, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72,
+	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x31, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x1a, 0x48,
+	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x26, 0x0a, 0x05, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x61, 0x72,
+	0x6b, 0x52, 0x05, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x84, 0x02, 0x0a, 0x0d, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x73, 0x1a, 0x6c, 0x0a, 0x06, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x42, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x41, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22,
+	0xaa, 0x05, 0x0a, 0x09, 0x45, 0x6d, 0x69, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x1a, 0x7f, 0x0a,
+	0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d,
+	0x69, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
+	0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x1a, 0xd5,
+	0x01, 0x0a, 0x03, 0x46, 0x69, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x69, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x2e, 0x46, 0x69,
+	0x78, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x64, 0x69, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54,
+	0x65, 0x78, 0x74, 0x45, 0x64, 0x69, 0x74, 0x52, 0x05, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xb8, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x69, 0x74, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66,
+	0x69, 0x78, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x69,
+	0x78, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x69,
+	0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x2e, 0x46, 0x69, 0x78, 0x52, 0x05, 0x66, 0x69, 0x78, 0x65,
+	0x73, 0x1a, 0x24, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x22, 0x63, 0x0a, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x57, 0x41,
+	0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x45, 0x56, 0x45, 0x52,
+	0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x43, 0x45, 0x10, 0x03, 0x22, 0xcd, 0x01, 0x0a,
+	0x0c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x1a, 0xb0, 0x01,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x25, 0x0a,
+	0x05, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x45, 0x64, 0x69, 0x74, 0x52, 0x05, 0x65,
+	0x64, 0x69, 0x74, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x90, 0x03, 0x0a,
+	0x0a, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x3b, 0x0a, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x4d, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x1a, 0x72, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6c,
+	0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x6e, 0x6c, 0x79, 0x1a, 0x63, 0x0a, 0x05, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x6f, 0x64, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22,
+	0xeb, 0x06, 0x0a, 0x0b, 0x42, 0x6f, 0x64, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x42, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0xaf, 0x01, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x6e,
+	0x61, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x01, 0x10,
-	0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x1a, 0x48, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x61, 0x72,
-	0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x05, 0x6d, 0x61, 0x72, 0x6b,
-	0x73, 0x22, 0xa6, 0x03, 0x0a, 0x09, 0x45, 0x6d, 0x69, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x1a,
-	0x7f, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x45, 0x6d, 0x69, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69,
-	0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b,
-	0x1a, 0x8c, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x04,
-	0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x69, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x2e, 0x52, 0x75, 0x6c,
-	0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x22, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05,
-	0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x78, 0x61, 0x62, 0x6c, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x69, 0x78, 0x61, 0x62, 0x6c, 0x65, 0x1a,
-	0x24, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x65, 0x64, 0x22, 0x63, 0x0a, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
-	0x79, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53,
-	0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12,
-	0x14, 0x0a, 0x10, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x57, 0x41, 0x52, 0x4e,
-	0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54,
-	0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x43, 0x45, 0x10, 0x03, 0x22, 0xa6, 0x01, 0x0a, 0x0c, 0x41,
-	0x70, 0x70, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x1a, 0x89, 0x01, 0x0a, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0xc3, 0x02, 0x0a, 0x0a, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x12, 0x3b, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
-	0x6f, 0x64, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12,
-	0x2f, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
-	0x12, 0x25, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4d, 0x6f, 0x64,
-	0x65, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x1a, 0x3b, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x1a, 0x63, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xf9, 0x04, 0x0a, 0x0b, 0x42, 0x6f,
-	0x64, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0a, 0x61, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x30, 0x0a,
-	0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a,
-	0xaf, 0x01, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x22, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05,
-	0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x61,
-	0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
-	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10,
-	0x04, 0x1a, 0xe4, 0x01, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f,
-	0x64, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12,
-	0x29, 0x0a, 0x09, 0x64, 0x65, 0x66, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x08, 0x64, 0x65, 0x66, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x74, 0x79,
-	0x70, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x79,
-	0x70, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x0c, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x1a, 0x5b, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8f, 0x01, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x72, 0x61,
-	0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x31, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6d, 0x61,
-	0x72, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x0a, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x2e, 0x50, 0x6f, 0x73, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x1a, 0x45, 0x0a, 0x03, 0x50, 0x6f, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
-	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a, 0x04,
-	0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x62, 0x79, 0x74, 0x65,
-	0x22, 0xd8, 0x01, 0x0a, 0x0d, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x50, 0x61,
-	0x74, 0x68, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74,
-	0x65, 0x70, 0x73, 0x1a, 0x95, 0x01, 0x0a, 0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x27, 0x0a, 0x0e,
-	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x10, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
-	0x52, 0x0d, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x42,
-	0x0a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x71, 0x0a, 0x09, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x22, 0x4d,
-	0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x24, 0x0a,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x64, 0x0a,
-	0x0d, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f,
-	0x0a, 0x1b, 0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x43, 0x54, 0x58, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x18, 0x0a, 0x14, 0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x43, 0x54, 0x58, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x46, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x4f, 0x44,
-	0x55, 0x4c, 0x45, 0x5f, 0x43, 0x54, 0x58, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x4f, 0x4f,
-	0x54, 0x10, 0x02, 0x2a, 0x63, 0x0a, 0x0a, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4d, 0x6f, 0x64,
-	0x65, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17,
-	0x0a, 0x13, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x45,
-	0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x43, 0x48, 0x45, 0x4d,
-	0x41, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4a, 0x55, 0x53, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x52,
-	0x49, 0x42, 0x55, 0x54, 0x45, 0x53, 0x10, 0x02, 0x2a, 0x53, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43,
+	0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x02, 0x10,
+	0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x1a, 0xd6, 0x03, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x26, 0x0a,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x29, 0x0a, 0x09, 0x64, 0x65, 0x66, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x64, 0x65, 0x66, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x2b, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x09, 0x74, 0x79, 0x70, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2f, 0x0a,
+	0x0c, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x0b, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x3d,
+	0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0xb0, 0x01,
+	0x0a, 0x08, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x07, 0x69, 0x6e,
+	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06, 0x69,
+	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x31, 0x0a, 0x0b,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d,
+	0x61, 0x72, 0x6b, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x42, 0x05, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x1a, 0x5b, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x64,
+	0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8f, 0x01,
+	0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x31, 0x0a, 0x0b,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d,
+	0x61, 0x72, 0x6b, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x73, 0x22,
+	0xb6, 0x01, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x22, 0x0a,
+	0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x1a, 0x45, 0x0a, 0x03, 0x50, 0x6f, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x62, 0x79, 0x74, 0x65, 0x22, 0x49, 0x0a, 0x08, 0x54, 0x65, 0x78, 0x74,
+	0x45, 0x64, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x54,
+	0x65, 0x78, 0x74, 0x22, 0xd8, 0x01, 0x0a, 0x0d, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x52,
+	0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x1a, 0x95, 0x01, 0x0a, 0x04, 0x53, 0x74, 0x65, 0x70, 0x12,
+	0x27, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x10, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4b,
+	0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x49,
+	0x6e, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x71,
+	0x0a, 0x09, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x12, 0x28, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61,
+	0x6c, 0x22, 0x4d, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x12, 0x24, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2a, 0x64, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x74, 0x78, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x43, 0x54, 0x58, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x43, 0x54, 0x58,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x46, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
+	0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x43, 0x54, 0x58, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x52, 0x4f, 0x4f, 0x54, 0x10, 0x02, 0x2a, 0x7e, 0x0a, 0x0a, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x4d,
 	0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
-	0x53, 0x45, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x56, 0x45, 0x10, 0x04, 0x22, 0x04, 0x08, 0x01, 0x10,
-	0x01, 0x22, 0x04, 0x08, 0x02, 0x10, 0x02, 0x22, 0x04, 0x08, 0x03, 0x10, 0x03, 0x32, 0xb2, 0x05,
-	0x0a, 0x07, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x47, 0x65,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x43,
+	0x48, 0x45, 0x4d, 0x41, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4a, 0x55, 0x53, 0x54, 0x5f, 0x41,
+	0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x53, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x53,
+	0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x55, 0x52,
+	0x53, 0x49, 0x56, 0x45, 0x10, 0x03, 0x2a, 0x53, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x18, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45,
+	0x4e, 0x53, 0x49, 0x54, 0x49, 0x56, 0x45, 0x10, 0x04, 0x22, 0x04, 0x08, 0x01, 0x10, 0x01, 0x22,
+	0x04, 0x08, 0x02, 0x10, 0x02, 0x22, 0x04, 0x08, 0x03, 0x10, 0x03, 0x32, 0xb2, 0x05, 0x0a, 0x07,
+	0x52, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
 	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x2e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72,
-	0x61, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x53, 0x44, 0x4b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x44, 0x4b, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70,
+	0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x53, 0x44, 0x4b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x44, 0x4b, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x47,
-	0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x41, 0x70,
-	0x70, 0x6c, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47, 0x6c, 0x6f,
-	0x62, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47,
-	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x6c,
-	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x32, 0xb1, 0x05, 0x0a, 0x06, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x4c, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x77, 0x64, 0x12, 0x1c,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x61, 0x6c, 0x77, 0x64, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70,
+	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x44, 0x4b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0xd3, 0x06, 0x0a, 0x06, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x77, 0x64, 0x12, 0x1c, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
-	0x77, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x61,
-	0x74, 0x68, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
+	0x77, 0x64, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x77, 0x64,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d,
+	0x0a, 0x08, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46,
-	0x69, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08,
-	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x47,
-	0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
-	0x0a, 0x0c, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x12, 0x1b,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45,
-	0x78, 0x70, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72,
+	0x12, 0x49, 0x0a, 0x0c, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72,
+	0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x65, 0x45, 0x78, 0x70, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78,
+	0x70, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70,
+	0x72, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x73,
 	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x45, 0x6d, 0x69,
 	0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
 	0x6d, 0x69, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
@@ -4026,177 +4771,205 @@ func file_tflint_proto_rawDescGZIP() []byte {
 	return file_tflint_proto_rawDescData
 }
 
-var file_tflint_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_tflint_proto_msgTypes = make([]protoimpl.MessageInfo, 77)
+var file_tflint_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_tflint_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
 var file_tflint_proto_goTypes = []any{
 	(ModuleCtxType)(0),                    // 0: proto.ModuleCtxType
 	(SchemaMode)(0),                       // 1: proto.SchemaMode
 	(ErrorCode)(0),                        // 2: proto.ErrorCode
-	(GetModuleContent_ExpandMode)(0),      // 3: proto.GetModuleContent.ExpandMode
-	(EmitIssue_Severity)(0),               // 4: proto.EmitIssue.Severity
-	(*GetName)(nil),                       // 5: proto.GetName
-	(*GetVersion)(nil),                    // 6: proto.GetVersion
-	(*GetVersionConstraint)(nil),          // 7: proto.GetVersionConstraint
-	(*GetSDKVersion)(nil),                 // 8: proto.GetSDKVersion
-	(*GetRuleNames)(nil),                  // 9: proto.GetRuleNames
-	(*GetConfigSchema)(nil),               // 10: proto.GetConfigSchema
-	(*ApplyGlobalConfig)(nil),             // 11: proto.ApplyGlobalConfig
-	(*ApplyConfig)(nil),                   // 12: proto.ApplyConfig
-	(*Check)(nil),                         // 13: proto.Check
-	(*GetOriginalwd)(nil),                 // 14: proto.GetOriginalwd
-	(*GetModulePath)(nil),                 // 15: proto.GetModulePath
-	(*GetModuleContent)(nil),              // 16: proto.GetModuleContent
-	(*GetFile)(nil),                       // 17: proto.GetFile
-	(*GetFiles)(nil),                      // 18: proto.GetFiles
-	(*GetRuleConfigContent)(nil),          // 19: proto.GetRuleConfigContent
-	(*EvaluateExpr)(nil),                  // 20: proto.EvaluateExpr
-	(*EmitIssue)(nil),                     // 21: proto.EmitIssue
-	(*ApplyChanges)(nil),                  // 22: proto.ApplyChanges
-	(*BodySchema)(nil),                    // 23: proto.BodySchema
-	(*BodyContent)(nil),                   // 24: proto.BodyContent
-	(*Expression)(nil),                    // 25: proto.Expression
-	(*Range)(nil),                         // 26: proto.Range
-	(*AttributePath)(nil),                 // 27: proto.AttributePath
-	(*ValueMark)(nil),                     // 28: proto.ValueMark
-	(*ErrorDetail)(nil),                   // 29: proto.ErrorDetail
-	(*GetName_Request)(nil),               // 30: proto.GetName.Request
-	(*GetName_Response)(nil),              // 31: proto.GetName.Response
-	(*GetVersion_Request)(nil),            // 32: proto.GetVersion.Request
-	(*GetVersion_Response)(nil),           // 33: proto.GetVersion.Response
-	(*GetVersionConstraint_Request)(nil),  // 34: proto.GetVersionConstraint.Request
-	(*GetVersionConstraint_Response)(nil), // 35: proto.GetVersionConstraint.Response
-	(*GetSDKVersion_Request)(nil),         // 36: proto.GetSDKVersion.Request
-	(*GetSDKVersion_Response)(nil),        // 37: proto.GetSDKVersion.Response
-	(*GetRuleNames_Request)(nil),          // 38: proto.GetRuleNames.Request
-	(*GetRuleNames_Response)(nil),         // 39: proto.GetRuleNames.Response
-	(*GetConfigSchema_Request)(nil),       // 40: proto.GetConfigSchema.Request
-	(*GetConfigSchema_Response)(nil),      // 41: proto.GetConfigSchema.Response
-	(*ApplyGlobalConfig_Config)(nil),      // 42: proto.ApplyGlobalConfig.Config
-	(*ApplyGlobalConfig_RuleConfig)(nil),  // 43: proto.ApplyGlobalConfig.RuleConfig
-	(*ApplyGlobalConfig_Request)(nil),     // 44: proto.ApplyGlobalConfig.Request
-	(*ApplyGlobalConfig_Response)(nil),    // 45: proto.ApplyGlobalConfig.Response
-	nil,                                   // 46: proto.ApplyGlobalConfig.Config.RulesEntry
-	(*ApplyConfig_Request)(nil),           // 47: proto.ApplyConfig.Request
-	(*ApplyConfig_Response)(nil),          // 48: proto.ApplyConfig.Response
-	(*Check_Request)(nil),                 // 49: proto.Check.Request
-	(*Check_Response)(nil),                // 50: proto.Check.Response
-	(*GetOriginalwd_Request)(nil),         // 51: proto.GetOriginalwd.Request
-	(*GetOriginalwd_Response)(nil),        // 52: proto.GetOriginalwd.Response
-	(*GetModulePath_Request)(nil),         // 53: proto.GetModulePath.Request
-	(*GetModulePath_Response)(nil),        // 54: proto.GetModulePath.Response
-	(*GetModuleContent_Hint)(nil),         // 55: proto.GetModuleContent.Hint
-	(*GetModuleContent_Option)(nil),       // 56: proto.GetModuleContent.Option
-	(*GetModuleContent_Request)(nil),      // 57: proto.GetModuleContent.Request
-	(*GetModuleContent_Response)(nil),     // 58: proto.GetModuleContent.Response
-	(*GetFile_Request)(nil),               // 59: proto.GetFile.Request
-	(*GetFile_Response)(nil),              // 60: proto.GetFile.Response
-	(*GetFiles_Request)(nil),              // 61: proto.GetFiles.Request
-	(*GetFiles_Response)(nil),             // 62: proto.GetFiles.Response
-	nil,                                   // 63: proto.GetFiles.Response.FilesEntry
-	(*GetRuleConfigContent_Request)(nil),  // 64: proto.GetRuleConfigContent.Request
-	(*GetRuleConfigContent_Response)(nil), // 65: proto.GetRuleConfigContent.Response
-	(*EvaluateExpr_Option)(nil),           // 66: proto.EvaluateExpr.Option
-	(*EvaluateExpr_Request)(nil),          // 67: proto.EvaluateExpr.Request
-	(*EvaluateExpr_Response)(nil),         // 68: proto.EvaluateExpr.Response
-	(*EmitIssue_Rule)(nil),                // 69: proto.EmitIssue.Rule
-	(*EmitIssue_Request)(nil),             // 70: proto.EmitIssue.Request
-	(*EmitIssue_Response)(nil),            // 71: proto.EmitIssue.Response
-	(*ApplyChanges_Request)(nil),          // 72: proto.ApplyChanges.Request
-	(*ApplyChanges_Response)(nil),         // 73: proto.ApplyChanges.Response
-	nil,                                   // 74: proto.ApplyChanges.Request.ChangesEntry
-	(*BodySchema_Attribute)(nil),          // 75: proto.BodySchema.Attribute
-	(*BodySchema_Block)(nil),              // 76: proto.BodySchema.Block
-	(*BodyContent_Attribute)(nil),         // 77: proto.BodyContent.Attribute
-	(*BodyContent_Block)(nil),             // 78: proto.BodyContent.Block
-	nil,                                   // 79: proto.BodyContent.AttributesEntry
-	(*Range_Pos)(nil),                     // 80: proto.Range.Pos
-	(*AttributePath_Step)(nil),            // 81: proto.AttributePath.Step
+	(ApplyGlobalConfig_FormatMode)(0),     // 3: proto.ApplyGlobalConfig.FormatMode
+	(GetModuleContent_ExpandMode)(0),      // 4: proto.GetModuleContent.ExpandMode
+	(EmitIssue_Severity)(0),               // 5: proto.EmitIssue.Severity
+	(*GetName)(nil),                       // 6: proto.GetName
+	(*GetVersion)(nil),                    // 7: proto.GetVersion
+	(*GetVersionConstraint)(nil),          // 8: proto.GetVersionConstraint
+	(*GetSDKVersion)(nil),                 // 9: proto.GetSDKVersion
+	(*GetRuleNames)(nil),                  // 10: proto.GetRuleNames
+	(*GetConfigSchema)(nil),               // 11: proto.GetConfigSchema
+	(*ApplyGlobalConfig)(nil),             // 12: proto.ApplyGlobalConfig
+	(*ApplyConfig)(nil),                   // 13: proto.ApplyConfig
+	(*Check)(nil),                         // 14: proto.Check
+	(*GetOriginalwd)(nil),                 // 15: proto.GetOriginalwd
+	(*GetModulePath)(nil),                 // 16: proto.GetModulePath
+	(*GetModuleSource)(nil),               // 17: proto.GetModuleSource
+	(*GetModuleContent)(nil),              // 18: proto.GetModuleContent
+	(*GetFile)(nil),                       // 19: proto.GetFile
+	(*GetFiles)(nil),                      // 20: proto.GetFiles
+	(*GetRuleConfigContent)(nil),          // 21: proto.GetRuleConfigContent
+	(*EvaluateExpr)(nil),                  // 22: proto.EvaluateExpr
+	(*EvaluateExprs)(nil),                 // 23: proto.EvaluateExprs
+	(*EmitIssue)(nil),                     // 24: proto.EmitIssue
+	(*ApplyChanges)(nil),                  // 25: proto.ApplyChanges
+	(*BodySchema)(nil),                    // 26: proto.BodySchema
+	(*BodyContent)(nil),                   // 27: proto.BodyContent
+	(*Expression)(nil),                    // 28: proto.Expression
+	(*Range)(nil),                         // 29: proto.Range
+	(*TextEdit)(nil),                      // 30: proto.TextEdit
+	(*AttributePath)(nil),                 // 31: proto.AttributePath
+	(*ValueMark)(nil),                     // 32: proto.ValueMark
+	(*ErrorDetail)(nil),                   // 33: proto.ErrorDetail
+	(*GetName_Request)(nil),               // 34: proto.GetName.Request
+	(*GetName_Response)(nil),              // 35: proto.GetName.Response
+	(*GetVersion_Request)(nil),            // 36: proto.GetVersion.Request
+	(*GetVersion_Response)(nil),           // 37: proto.GetVersion.Response
+	(*GetVersionConstraint_Request)(nil),  // 38: proto.GetVersionConstraint.Request
+	(*GetVersionConstraint_Response)(nil), // 39: proto.GetVersionConstraint.Response
+	(*GetSDKVersion_Request)(nil),         // 40: proto.GetSDKVersion.Request
+	(*GetSDKVersion_Response)(nil),        // 41: proto.GetSDKVersion.Response
+	(*GetRuleNames_Request)(nil),          // 42: proto.GetRuleNames.Request
+	(*GetRuleNames_Response)(nil),         // 43: proto.GetRuleNames.Response
+	(*GetConfigSchema_Request)(nil),       // 44: proto.GetConfigSchema.Request
+	(*GetConfigSchema_Response)(nil),      // 45: proto.GetConfigSchema.Response
+	(*ApplyGlobalConfig_Config)(nil),      // 46: proto.ApplyGlobalConfig.Config
+	(*ApplyGlobalConfig_RuleConfig)(nil),  // 47: proto.ApplyGlobalConfig.RuleConfig
+	(*ApplyGlobalConfig_Request)(nil),     // 48: proto.ApplyGlobalConfig.Request
+	(*ApplyGlobalConfig_Response)(nil),    // 49: proto.ApplyGlobalConfig.Response
+	nil,                                   // 50: proto.ApplyGlobalConfig.Config.RulesEntry
+	(*ApplyConfig_Request)(nil),           // 51: proto.ApplyConfig.Request
+	(*ApplyConfig_Response)(nil),          // 52: proto.ApplyConfig.Response
+	(*Check_Request)(nil),                 // 53: proto.Check.Request
+	(*Check_Response)(nil),                // 54: proto.Check.Response
+	(*GetOriginalwd_Request)(nil),         // 55: proto.GetOriginalwd.Request
+	(*GetOriginalwd_Response)(nil),        // 56: proto.GetOriginalwd.Response
+	(*GetModulePath_Request)(nil),         // 57: proto.GetModulePath.Request
+	(*GetModulePath_Response)(nil),        // 58: proto.GetModulePath.Response
+	(*GetModuleSource_Request)(nil),       // 59: proto.GetModuleSource.Request
+	(*GetModuleSource_Response)(nil),      // 60: proto.GetModuleSource.Response
+	(*GetModuleContent_Hint)(nil),         // 61: proto.GetModuleContent.Hint
+	(*GetModuleContent_Option)(nil),       // 62: proto.GetModuleContent.Option
+	(*GetModuleContent_Request)(nil),      // 63: proto.GetModuleContent.Request
+	(*GetModuleContent_Response)(nil),     // 64: proto.GetModuleContent.Response
+	(*GetFile_Request)(nil),               // 65: proto.GetFile.Request
+	(*GetFile_Response)(nil),              // 66: proto.GetFile.Response
+	(*GetFiles_Request)(nil),              // 67: proto.GetFiles.Request
+	(*GetFiles_Response)(nil),             // 68: proto.GetFiles.Response
+	nil,                                   // 69: proto.GetFiles.Response.FilesEntry
+	(*GetRuleConfigContent_Request)(nil),  // 70: proto.GetRuleConfigContent.Request
+	(*GetRuleConfigContent_Response)(nil), // 71: proto.GetRuleConfigContent.Response
+	(*EvaluateExpr_Option)(nil),           // 72: proto.EvaluateExpr.Option
+	(*EvaluateExpr_Request)(nil),          // 73: proto.EvaluateExpr.Request
+	(*EvaluateExpr_Response)(nil),         // 74: proto.EvaluateExpr.Response
+	(*EvaluateExprs_Result)(nil),          // 75: proto.EvaluateExprs.Result
+	(*EvaluateExprs_Request)(nil),         // 76: proto.EvaluateExprs.Request
+	(*EvaluateExprs_Response)(nil),        // 77: proto.EvaluateExprs.Response
+	(*EmitIssue_Rule)(nil),                // 78: proto.EmitIssue.Rule
+	(*EmitIssue_Fix)(nil),                 // 79: proto.EmitIssue.Fix
+	(*EmitIssue_Request)(nil),             // 80: proto.EmitIssue.Request
+	(*EmitIssue_Response)(nil),            // 81: proto.EmitIssue.Response
+	nil,                                   // 82: proto.EmitIssue.Fix.ChangesEntry
+	(*ApplyChanges_Request)(nil),          // 83: proto.ApplyChanges.Request
+	(*ApplyChanges_Response)(nil),         // 84: proto.ApplyChanges.Response
+	nil,                                   // 85: proto.ApplyChanges.Request.ChangesEntry
+	(*BodySchema_Attribute)(nil),          // 86: proto.BodySchema.Attribute
+	(*BodySchema_Block)(nil),              // 87: proto.BodySchema.Block
+	(*BodyContent_Attribute)(nil),         // 88: proto.BodyContent.Attribute
+	(*BodyContent_Block)(nil),             // 89: proto.BodyContent.Block
+	nil,                                   // 90: proto.BodyContent.AttributesEntry
+	(*BodyContent_Block_Instance)(nil),    // 91: proto.BodyContent.Block.Instance
+	(*Range_Pos)(nil),                     // 92: proto.Range.Pos
+	(*AttributePath_Step)(nil),            // 93: proto.AttributePath.Step
 }
 var file_tflint_proto_depIdxs = []int32{
-	75, // 0: proto.BodySchema.attributes:type_name -> proto.BodySchema.Attribute
-	76, // 1: proto.BodySchema.blocks:type_name -> proto.BodySchema.Block
+	86, // 0: proto.BodySchema.attributes:type_name -> proto.BodySchema.Attribute
+	87, // 1: proto.BodySchema.blocks:type_name -> proto.BodySchema.Block
 	1,  // 2: proto.BodySchema.Mode:type_name -> proto.SchemaMode
-	79, // 3: proto.BodyContent.attributes:type_name -> proto.BodyContent.AttributesEntry
-	78, // 4: proto.BodyContent.blocks:type_name -> proto.BodyContent.Block
-	26, // 5: proto.Expression.range:type_name -> proto.Range
-	28, // 6: proto.Expression.value_marks:type_name -> proto.ValueMark
-	80, // 7: proto.Range.start:type_name -> proto.Range.Pos
-	80, // 8: proto.Range.end:type_name -> proto.Range.Pos
-	81, // 9: proto.AttributePath.steps:type_name -> proto.AttributePath.Step
-	27, // 10: proto.ValueMark.path:type_name -> proto.AttributePath
-	2,  // 11: proto.ErrorDetail.code:type_name -> proto.ErrorCode
-	23, // 12: proto.GetConfigSchema.Response.schema:type_name -> proto.BodySchema
-	46, // 13: proto.ApplyGlobalConfig.Config.rules:type_name -> proto.ApplyGlobalConfig.Config.RulesEntry
-	42, // 14: proto.ApplyGlobalConfig.Request.config:type_name -> proto.ApplyGlobalConfig.Config
-	43, // 15: proto.ApplyGlobalConfig.Config.RulesEntry.value:type_name -> proto.ApplyGlobalConfig.RuleConfig
-	24, // 16: proto.ApplyConfig.Request.content:type_name -> proto.BodyContent
-	0,  // 17: proto.GetModuleContent.Option.module_ctx:type_name -> proto.ModuleCtxType
-	55, // 18: proto.GetModuleContent.Option.hint:type_name -> proto.GetModuleContent.Hint
-	3,  // 19: proto.GetModuleContent.Option.expand_mode:type_name -> proto.GetModuleContent.ExpandMode
-	23, // 20: proto.GetModuleContent.Request.schema:type_name -> proto.BodySchema
-	56, // 21: proto.GetModuleContent.Request.option:type_name -> proto.GetModuleContent.Option
-	24, // 22: proto.GetModuleContent.Response.content:type_name -> proto.BodyContent
-	63, // 23: proto.GetFiles.Response.files:type_name -> proto.GetFiles.Response.FilesEntry
-	23, // 24: proto.GetRuleConfigContent.Request.schema:type_name -> proto.BodySchema
-	24, // 25: proto.GetRuleConfigContent.Response.content:type_name -> proto.BodyContent
-	0,  // 26: proto.EvaluateExpr.Option.module_ctx:type_name -> proto.ModuleCtxType
-	66, // 27: proto.EvaluateExpr.Request.option:type_name -> proto.EvaluateExpr.Option
-	25, // 28: proto.EvaluateExpr.Request.expression:type_name -> proto.Expression
-	28, // 29: proto.EvaluateExpr.Response.marks:type_name -> proto.ValueMark
-	4,  // 30: proto.EmitIssue.Rule.severity:type_name -> proto.EmitIssue.Severity
-	69, // 31: proto.EmitIssue.Request.rule:type_name -> proto.EmitIssue.Rule
-	26, // 32: proto.EmitIssue.Request.range:type_name -> proto.Range
-	74, // 33: proto.ApplyChanges.Request.changes:type_name -> proto.ApplyChanges.Request.ChangesEntry
-	23, // 34: proto.BodySchema.Block.body:type_name -> proto.BodySchema
-	26, // 35: proto.BodyContent.Attribute.range:type_name -> proto.Range
-	26, // 36: proto.BodyContent.Attribute.name_range:type_name -> proto.Range
-	25, // 37: proto.BodyContent.Attribute.expression:type_name -> proto.Expression
-	24, // 38: proto.BodyContent.Block.body:type_name -> proto.BodyContent
-	26, // 39: proto.BodyContent.Block.def_range:type_name -> proto.Range
-	26, // 40: proto.BodyContent.Block.type_range:type_name -> proto.Range
-	26, // 41: proto.BodyContent.Block.label_ranges:type_name -> proto.Range
-	77, // 42: proto.BodyContent.AttributesEntry.value:type_name -> proto.BodyContent.Attribute
-	30, // 43: proto.RuleSet.GetName:input_type -> proto.GetName.Request
-	32, // 44: proto.RuleSet.GetVersion:input_type -> proto.GetVersion.Request
-	34, // 45: proto.RuleSet.GetVersionConstraint:input_type -> proto.GetVersionConstraint.Request
-	36, // 46: proto.RuleSet.GetSDKVersion:input_type -> proto.GetSDKVersion.Request
-	38, // 47: proto.RuleSet.GetRuleNames:input_type -> proto.GetRuleNames.Request
-	40, // 48: proto.RuleSet.GetConfigSchema:input_type -> proto.GetConfigSchema.Request
-	44, // 49: proto.RuleSet.ApplyGlobalConfig:input_type -> proto.ApplyGlobalConfig.Request
-	47, // 50: proto.RuleSet.ApplyConfig:input_type -> proto.ApplyConfig.Request
-	49, // 51: proto.RuleSet.Check:input_type -> proto.Check.Request
-	51, // 52: proto.Runner.GetOriginalwd:input_type -> proto.GetOriginalwd.Request
-	53, // 53: proto.Runner.GetModulePath:input_type -> proto.GetModulePath.Request
-	57, // 54: proto.Runner.GetModuleContent:input_type -> proto.GetModuleContent.Request
-	59, // 55: proto.Runner.GetFile:input_type -> proto.GetFile.Request
-	61, // 56: proto.Runner.GetFiles:input_type -> proto.GetFiles.Request
-	64, // 57: proto.Runner.GetRuleConfigContent:input_type -> proto.GetRuleConfigContent.Request
-	67, // 58: proto.Runner.EvaluateExpr:input_type -> proto.EvaluateExpr.Request
-	70, // 59: proto.Runner.EmitIssue:input_type -> proto.EmitIssue.Request
-	72, // 60: proto.Runner.ApplyChanges:input_type -> proto.ApplyChanges.Request
-	31, // 61: proto.RuleSet.GetName:output_type -> proto.GetName.Response
-	33, // 62: proto.RuleSet.GetVersion:output_type -> proto.GetVersion.Response
-	35, // 63: proto.RuleSet.GetVersionConstraint:output_type -> proto.GetVersionConstraint.Response
-	37, // 64: proto.RuleSet.GetSDKVersion:output_type -> proto.GetSDKVersion.Response
-	39, // 65: proto.RuleSet.GetRuleNames:output_type -> proto.GetRuleNames.Response
-	41, // 66: proto.RuleSet.GetConfigSchema:output_type -> proto.GetConfigSchema.Response
-	45, // 67: proto.RuleSet.ApplyGlobalConfig:output_type -> proto.ApplyGlobalConfig.Response
-	48, // 68: proto.RuleSet.ApplyConfig:output_type -> proto.ApplyConfig.Response
-	50, // 69: proto.RuleSet.Check:output_type -> proto.Check.Response
-	52, // 70: proto.Runner.GetOriginalwd:output_type -> proto.GetOriginalwd.Response
-	54, // 71: proto.Runner.GetModulePath:output_type -> proto.GetModulePath.Response
-	58, // 72: proto.Runner.GetModuleContent:output_type -> proto.GetModuleContent.Response
-	60, // 73: proto.Runner.GetFile:output_type -> proto.GetFile.Response
-	62, // 74: proto.Runner.GetFiles:output_type -> proto.GetFiles.Response
-	65, // 75: proto.Runner.GetRuleConfigContent:output_type -> proto.GetRuleConfigContent.Response
-	68, // 76: proto.Runner.EvaluateExpr:output_type -> proto.EvaluateExpr.Response
-	71, // 77: proto.Runner.EmitIssue:output_type -> proto.EmitIssue.Response
-	73, // 78: proto.Runner.ApplyChanges:output_type -> proto.ApplyChanges.Response
-	61, // [61:79] is the sub-list for method output_type
-	43, // [43:61] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+	90, // 3: proto.BodyContent.attributes:type_name -> proto.BodyContent.AttributesEntry
+	89, // 4: proto.BodyContent.blocks:type_name -> proto.BodyContent.Block
+	29, // 5: proto.Expression.range:type_name -> proto.Range
+	32, // 6: proto.Expression.value_marks:type_name -> proto.ValueMark
+	92, // 7: proto.Range.start:type_name -> proto.Range.Pos
+	92, // 8: proto.Range.end:type_name -> proto.Range.Pos
+	29, // 9: proto.TextEdit.range:type_name -> proto.Range
+	93, // 10: proto.AttributePath.steps:type_name -> proto.AttributePath.Step
+	31, // 11: proto.ValueMark.path:type_name -> proto.AttributePath
+	2,  // 12: proto.ErrorDetail.code:type_name -> proto.ErrorCode
+	26, // 13: proto.GetConfigSchema.Response.schema:type_name -> proto.BodySchema
+	50, // 14: proto.ApplyGlobalConfig.Config.rules:type_name -> proto.ApplyGlobalConfig.Config.RulesEntry
+	3,  // 15: proto.ApplyGlobalConfig.Config.format_mode:type_name -> proto.ApplyGlobalConfig.FormatMode
+	46, // 16: proto.ApplyGlobalConfig.Request.config:type_name -> proto.ApplyGlobalConfig.Config
+	47, // 17: proto.ApplyGlobalConfig.Config.RulesEntry.value:type_name -> proto.ApplyGlobalConfig.RuleConfig
+	27, // 18: proto.ApplyConfig.Request.content:type_name -> proto.BodyContent
+	0,  // 19: proto.GetModuleContent.Option.module_ctx:type_name -> proto.ModuleCtxType
+	61, // 20: proto.GetModuleContent.Option.hint:type_name -> proto.GetModuleContent.Hint
+	4,  // 21: proto.GetModuleContent.Option.expand_mode:type_name -> proto.GetModuleContent.ExpandMode
+	26, // 22: proto.GetModuleContent.Request.schema:type_name -> proto.BodySchema
+	62, // 23: proto.GetModuleContent.Request.option:type_name -> proto.GetModuleContent.Option
+	27, // 24: proto.GetModuleContent.Response.content:type_name -> proto.BodyContent
+	69, // 25: proto.GetFiles.Response.files:type_name -> proto.GetFiles.Response.FilesEntry
+	26, // 26: proto.GetRuleConfigContent.Request.schema:type_name -> proto.BodySchema
+	27, // 27: proto.GetRuleConfigContent.Response.content:type_name -> proto.BodyContent
+	0,  // 28: proto.EvaluateExpr.Option.module_ctx:type_name -> proto.ModuleCtxType
+	72, // 29: proto.EvaluateExpr.Request.option:type_name -> proto.EvaluateExpr.Option
+	28, // 30: proto.EvaluateExpr.Request.expression:type_name -> proto.Expression
+	32, // 31: proto.EvaluateExpr.Response.marks:type_name -> proto.ValueMark
+	74, // 32: proto.EvaluateExprs.Result.response:type_name -> proto.EvaluateExpr.Response
+	33, // 33: proto.EvaluateExprs.Result.error:type_name -> proto.ErrorDetail
+	73, // 34: proto.EvaluateExprs.Request.requests:type_name -> proto.EvaluateExpr.Request
+	75, // 35: proto.EvaluateExprs.Response.results:type_name -> proto.EvaluateExprs.Result
+	5,  // 36: proto.EmitIssue.Rule.severity:type_name -> proto.EmitIssue.Severity
+	82, // 37: proto.EmitIssue.Fix.changes:type_name -> proto.EmitIssue.Fix.ChangesEntry
+	30, // 38: proto.EmitIssue.Fix.edits:type_name -> proto.TextEdit
+	78, // 39: proto.EmitIssue.Request.rule:type_name -> proto.EmitIssue.Rule
+	29, // 40: proto.EmitIssue.Request.range:type_name -> proto.Range
+	79, // 41: proto.EmitIssue.Request.fixes:type_name -> proto.EmitIssue.Fix
+	85, // 42: proto.ApplyChanges.Request.changes:type_name -> proto.ApplyChanges.Request.ChangesEntry
+	30, // 43: proto.ApplyChanges.Request.edits:type_name -> proto.TextEdit
+	26, // 44: proto.BodySchema.Block.body:type_name -> proto.BodySchema
+	29, // 45: proto.BodyContent.Attribute.range:type_name -> proto.Range
+	29, // 46: proto.BodyContent.Attribute.name_range:type_name -> proto.Range
+	28, // 47: proto.BodyContent.Attribute.expression:type_name -> proto.Expression
+	27, // 48: proto.BodyContent.Block.body:type_name -> proto.BodyContent
+	29, // 49: proto.BodyContent.Block.def_range:type_name -> proto.Range
+	29, // 50: proto.BodyContent.Block.type_range:type_name -> proto.Range
+	29, // 51: proto.BodyContent.Block.label_ranges:type_name -> proto.Range
+	91, // 52: proto.BodyContent.Block.instance:type_name -> proto.BodyContent.Block.Instance
+	88, // 53: proto.BodyContent.AttributesEntry.value:type_name -> proto.BodyContent.Attribute
+	32, // 54: proto.BodyContent.Block.Instance.value_marks:type_name -> proto.ValueMark
+	34, // 55: proto.RuleSet.GetName:input_type -> proto.GetName.Request
+	36, // 56: proto.RuleSet.GetVersion:input_type -> proto.GetVersion.Request
+	38, // 57: proto.RuleSet.GetVersionConstraint:input_type -> proto.GetVersionConstraint.Request
+	40, // 58: proto.RuleSet.GetSDKVersion:input_type -> proto.GetSDKVersion.Request
+	42, // 59: proto.RuleSet.GetRuleNames:input_type -> proto.GetRuleNames.Request
+	44, // 60: proto.RuleSet.GetConfigSchema:input_type -> proto.GetConfigSchema.Request
+	48, // 61: proto.RuleSet.ApplyGlobalConfig:input_type -> proto.ApplyGlobalConfig.Request
+	51, // 62: proto.RuleSet.ApplyConfig:input_type -> proto.ApplyConfig.Request
+	53, // 63: proto.RuleSet.Check:input_type -> proto.Check.Request
+	55, // 64: proto.Runner.GetOriginalwd:input_type -> proto.GetOriginalwd.Request
+	57, // 65: proto.Runner.GetModulePath:input_type -> proto.GetModulePath.Request
+	59, // 66: proto.Runner.GetModuleSource:input_type -> proto.GetModuleSource.Request
+	63, // 67: proto.Runner.GetModuleContent:input_type -> proto.GetModuleContent.Request
+	65, // 68: proto.Runner.GetFile:input_type -> proto.GetFile.Request
+	67, // 69: proto.Runner.GetFiles:input_type -> proto.GetFiles.Request
+	70, // 70: proto.Runner.GetRuleConfigContent:input_type -> proto.GetRuleConfigContent.Request
+	73, // 71: proto.Runner.EvaluateExpr:input_type -> proto.EvaluateExpr.Request
+	76, // 72: proto.Runner.EvaluateExprs:input_type -> proto.EvaluateExprs.Request
+	80, // 73: proto.Runner.EmitIssue:input_type -> proto.EmitIssue.Request
+	83, // 74: proto.Runner.ApplyChanges:input_type -> proto.ApplyChanges.Request
+	35, // 75: proto.RuleSet.GetName:output_type -> proto.GetName.Response
+	37, // 76: proto.RuleSet.GetVersion:output_type -> proto.GetVersion.Response
+	39, // 77: proto.RuleSet.GetVersionConstraint:output_type -> proto.GetVersionConstraint.Response
+	41, // 78: proto.RuleSet.GetSDKVersion:output_type -> proto.GetSDKVersion.Response
+	43, // 79: proto.RuleSet.GetRuleNames:output_type -> proto.GetRuleNames.Response
+	45, // 80: proto.RuleSet.GetConfigSchema:output_type -> proto.GetConfigSchema.Response
+	49, // 81: proto.RuleSet.ApplyGlobalConfig:output_type -> proto.ApplyGlobalConfig.Response
+	52, // 82: proto.RuleSet.ApplyConfig:output_type -> proto.ApplyConfig.Response
+	54, // 83: proto.RuleSet.Check:output_type -> proto.Check.Response
+	56, // 84: proto.Runner.GetOriginalwd:output_type -> proto.GetOriginalwd.Response
+	58, // 85: proto.Runner.GetModulePath:output_type -> proto.GetModulePath.Response
+	60, // 86: proto.Runner.GetModuleSource:output_type -> proto.GetModuleSource.Response
+	64, // 87: proto.Runner.GetModuleContent:output_type -> proto.GetModuleContent.Response
+	66, // 88: proto.Runner.GetFile:output_type -> proto.GetFile.Response
+	68, // 89: proto.Runner.GetFiles:output_type -> proto.GetFiles.Response
+	71, // 90: proto.Runner.GetRuleConfigContent:output_type -> proto.GetRuleConfigContent.Response
+	74, // 91: proto.Runner.EvaluateExpr:output_type -> proto.EvaluateExpr.Response
+	77, // 92: proto.Runner.EvaluateExprs:output_type -> proto.EvaluateExprs.Response
+	81, // 93: proto.Runner.EmitIssue:output_type -> proto.EmitIssue.Response
+	84, // 94: proto.Runner.ApplyChanges:output_type -> proto.ApplyChanges.Response
+	75, // [75:95] is the sub-list for method output_type
+	55, // [55:75] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_tflint_proto_init() }
@@ -4204,7 +4977,11 @@ func file_tflint_proto_init() {
 	if File_tflint_proto != nil {
 		return
 	}
-	file_tflint_proto_msgTypes[76].OneofWrappers = []any{
+	file_tflint_proto_msgTypes[85].OneofWrappers = []any{
+		(*BodyContent_Block_Instance_IntKey)(nil),
+		(*BodyContent_Block_Instance_StringKey)(nil),
+	}
+	file_tflint_proto_msgTypes[87].OneofWrappers = []any{
 		(*AttributePath_Step_AttributeName)(nil),
 		(*AttributePath_Step_ElementKeyString)(nil),
 		(*AttributePath_Step_ElementKeyInt)(nil),
@@ -4214,8 +4991,8 @@ func file_tflint_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tflint_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   77,
+			NumEnums:      6,
+			NumMessages:   88,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
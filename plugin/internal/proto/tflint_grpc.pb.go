@@ -427,11 +427,13 @@ var RuleSet_ServiceDesc = grpc.ServiceDesc{
 const (
 	Runner_GetOriginalwd_FullMethodName        = "/proto.Runner/GetOriginalwd"
 	Runner_GetModulePath_FullMethodName        = "/proto.Runner/GetModulePath"
+	Runner_GetModuleSource_FullMethodName      = "/proto.Runner/GetModuleSource"
 	Runner_GetModuleContent_FullMethodName     = "/proto.Runner/GetModuleContent"
 	Runner_GetFile_FullMethodName              = "/proto.Runner/GetFile"
 	Runner_GetFiles_FullMethodName             = "/proto.Runner/GetFiles"
 	Runner_GetRuleConfigContent_FullMethodName = "/proto.Runner/GetRuleConfigContent"
 	Runner_EvaluateExpr_FullMethodName         = "/proto.Runner/EvaluateExpr"
+	Runner_EvaluateExprs_FullMethodName        = "/proto.Runner/EvaluateExprs"
 	Runner_EmitIssue_FullMethodName            = "/proto.Runner/EmitIssue"
 	Runner_ApplyChanges_FullMethodName         = "/proto.Runner/ApplyChanges"
 )
@@ -442,11 +444,13 @@ const (
 type RunnerClient interface {
 	GetOriginalwd(ctx context.Context, in *GetOriginalwd_Request, opts ...grpc.CallOption) (*GetOriginalwd_Response, error)
 	GetModulePath(ctx context.Context, in *GetModulePath_Request, opts ...grpc.CallOption) (*GetModulePath_Response, error)
+	GetModuleSource(ctx context.Context, in *GetModuleSource_Request, opts ...grpc.CallOption) (*GetModuleSource_Response, error)
 	GetModuleContent(ctx context.Context, in *GetModuleContent_Request, opts ...grpc.CallOption) (*GetModuleContent_Response, error)
 	GetFile(ctx context.Context, in *GetFile_Request, opts ...grpc.CallOption) (*GetFile_Response, error)
 	GetFiles(ctx context.Context, in *GetFiles_Request, opts ...grpc.CallOption) (*GetFiles_Response, error)
 	GetRuleConfigContent(ctx context.Context, in *GetRuleConfigContent_Request, opts ...grpc.CallOption) (*GetRuleConfigContent_Response, error)
 	EvaluateExpr(ctx context.Context, in *EvaluateExpr_Request, opts ...grpc.CallOption) (*EvaluateExpr_Response, error)
+	EvaluateExprs(ctx context.Context, in *EvaluateExprs_Request, opts ...grpc.CallOption) (*EvaluateExprs_Response, error)
 	EmitIssue(ctx context.Context, in *EmitIssue_Request, opts ...grpc.CallOption) (*EmitIssue_Response, error)
 	ApplyChanges(ctx context.Context, in *ApplyChanges_Request, opts ...grpc.CallOption) (*ApplyChanges_Response, error)
 }
@@ -479,6 +483,16 @@ func (c *runnerClient) GetModulePath(ctx context.Context, in *GetModulePath_Requ
 	return out, nil
 }
 
+func (c *runnerClient) GetModuleSource(ctx context.Context, in *GetModuleSource_Request, opts ...grpc.CallOption) (*GetModuleSource_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetModuleSource_Response)
+	err := c.cc.Invoke(ctx, Runner_GetModuleSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runnerClient) GetModuleContent(ctx context.Context, in *GetModuleContent_Request, opts ...grpc.CallOption) (*GetModuleContent_Response, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetModuleContent_Response)
@@ -529,6 +543,16 @@ func (c *runnerClient) EvaluateExpr(ctx context.Context, in *EvaluateExpr_Reques
 	return out, nil
 }
 
+func (c *runnerClient) EvaluateExprs(ctx context.Context, in *EvaluateExprs_Request, opts ...grpc.CallOption) (*EvaluateExprs_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluateExprs_Response)
+	err := c.cc.Invoke(ctx, Runner_EvaluateExprs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runnerClient) EmitIssue(ctx context.Context, in *EmitIssue_Request, opts ...grpc.CallOption) (*EmitIssue_Response, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EmitIssue_Response)
@@ -555,11 +579,13 @@ func (c *runnerClient) ApplyChanges(ctx context.Context, in *ApplyChanges_Reques
 type RunnerServer interface {
 	GetOriginalwd(context.Context, *GetOriginalwd_Request) (*GetOriginalwd_Response, error)
 	GetModulePath(context.Context, *GetModulePath_Request) (*GetModulePath_Response, error)
+	GetModuleSource(context.Context, *GetModuleSource_Request) (*GetModuleSource_Response, error)
 	GetModuleContent(context.Context, *GetModuleContent_Request) (*GetModuleContent_Response, error)
 	GetFile(context.Context, *GetFile_Request) (*GetFile_Response, error)
 	GetFiles(context.Context, *GetFiles_Request) (*GetFiles_Response, error)
 	GetRuleConfigContent(context.Context, *GetRuleConfigContent_Request) (*GetRuleConfigContent_Response, error)
 	EvaluateExpr(context.Context, *EvaluateExpr_Request) (*EvaluateExpr_Response, error)
+	EvaluateExprs(context.Context, *EvaluateExprs_Request) (*EvaluateExprs_Response, error)
 	EmitIssue(context.Context, *EmitIssue_Request) (*EmitIssue_Response, error)
 	ApplyChanges(context.Context, *ApplyChanges_Request) (*ApplyChanges_Response, error)
 	mustEmbedUnimplementedRunnerServer()
@@ -578,6 +604,9 @@ func (UnimplementedRunnerServer) GetOriginalwd(context.Context, *GetOriginalwd_R
 func (UnimplementedRunnerServer) GetModulePath(context.Context, *GetModulePath_Request) (*GetModulePath_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetModulePath not implemented")
 }
+func (UnimplementedRunnerServer) GetModuleSource(context.Context, *GetModuleSource_Request) (*GetModuleSource_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModuleSource not implemented")
+}
 func (UnimplementedRunnerServer) GetModuleContent(context.Context, *GetModuleContent_Request) (*GetModuleContent_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetModuleContent not implemented")
 }
@@ -593,6 +622,9 @@ func (UnimplementedRunnerServer) GetRuleConfigContent(context.Context, *GetRuleC
 func (UnimplementedRunnerServer) EvaluateExpr(context.Context, *EvaluateExpr_Request) (*EvaluateExpr_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EvaluateExpr not implemented")
 }
+func (UnimplementedRunnerServer) EvaluateExprs(context.Context, *EvaluateExprs_Request) (*EvaluateExprs_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateExprs not implemented")
+}
 func (UnimplementedRunnerServer) EmitIssue(context.Context, *EmitIssue_Request) (*EmitIssue_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EmitIssue not implemented")
 }
@@ -656,6 +688,24 @@ func _Runner_GetModulePath_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Runner_GetModuleSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModuleSource_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetModuleSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetModuleSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetModuleSource(ctx, req.(*GetModuleSource_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Runner_GetModuleContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetModuleContent_Request)
 	if err := dec(in); err != nil {
@@ -746,6 +796,24 @@ func _Runner_EvaluateExpr_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Runner_EvaluateExprs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateExprs_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).EvaluateExprs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_EvaluateExprs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).EvaluateExprs(ctx, req.(*EvaluateExprs_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Runner_EmitIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmitIssue_Request)
 	if err := dec(in); err != nil {
@@ -797,6 +865,10 @@ var Runner_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetModulePath",
 			Handler:    _Runner_GetModulePath_Handler,
 		},
+		{
+			MethodName: "GetModuleSource",
+			Handler:    _Runner_GetModuleSource_Handler,
+		},
 		{
 			MethodName: "GetModuleContent",
 			Handler:    _Runner_GetModuleContent_Handler,
@@ -817,6 +889,10 @@ var Runner_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EvaluateExpr",
 			Handler:    _Runner_EvaluateExpr_Handler,
 		},
+		{
+			MethodName: "EvaluateExprs",
+			Handler:    _Runner_EvaluateExprs_Handler,
+		},
 		{
 			MethodName: "EmitIssue",
 			Handler:    _Runner_EmitIssue_Handler,
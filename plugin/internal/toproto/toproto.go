@@ -7,10 +7,12 @@ import (
 	"github.com/hashicorp/hcl/v2"
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/plugin/internal/proto"
+	"github.com/terraform-linters/tflint-plugin-sdk/terraform/addrs"
 	"github.com/terraform-linters/tflint-plugin-sdk/terraform/lang/marks"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/json"
 	"github.com/zclconf/go-cty/cty/msgpack"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -24,7 +26,14 @@ func BodySchema(body *hclext.BodySchema) *proto.BodySchema {
 
 	attributes := make([]*proto.BodySchema_Attribute, len(body.Attributes))
 	for idx, attr := range body.Attributes {
-		attributes[idx] = &proto.BodySchema_Attribute{Name: attr.Name, Required: attr.Required}
+		attributes[idx] = &proto.BodySchema_Attribute{Name: attr.Name, Required: attr.Required, LiteralOnly: attr.LiteralOnly}
+		if attr.Type != cty.NilType {
+			ty, err := json.MarshalType(attr.Type)
+			if err != nil {
+				panic(fmt.Sprintf("invalid type of %s attribute: %s", attr.Name, err))
+			}
+			attributes[idx].Type = ty
+		}
 	}
 
 	blocks := make([]*proto.BodySchema_Block, len(body.Blocks))
@@ -40,6 +49,7 @@ func BodySchema(body *hclext.BodySchema) *proto.BodySchema {
 		Mode:       SchemaMode(body.Mode),
 		Attributes: attributes,
 		Blocks:     blocks,
+		Depth:      int64(body.Depth),
 	}
 }
 
@@ -50,6 +60,8 @@ func SchemaMode(mode hclext.SchemaMode) proto.SchemaMode {
 		return proto.SchemaMode_SCHEMA_MODE_DEFAULT
 	case hclext.SchemaJustAttributesMode:
 		return proto.SchemaMode_SCHEMA_MODE_JUST_ATTRIBUTES
+	case hclext.SchemaRecursiveMode:
+		return proto.SchemaMode_SCHEMA_MODE_RECURSIVE
 	default:
 		panic(fmt.Sprintf("invalid SchemaMode: %s", mode))
 	}
@@ -90,6 +102,7 @@ func BodyContent(body *hclext.BodyContent, sources map[string][]byte) *proto.Bod
 			DefRange:    Range(block.DefRange),
 			TypeRange:   Range(block.TypeRange),
 			LabelRanges: labelRanges,
+			Instance:    BlockInstance(block.Instance),
 		}
 	}
 
@@ -99,6 +112,30 @@ func BodyContent(body *hclext.BodyContent, sources map[string][]byte) *proto.Bod
 	}
 }
 
+// BlockInstance converts hclext.BlockInstance to proto.BodyContent_Block_Instance
+func BlockInstance(instance *hclext.BlockInstance) *proto.BodyContent_Block_Instance {
+	if instance == nil {
+		return nil
+	}
+
+	out := &proto.BodyContent_Block_Instance{Dynamic: instance.Dynamic}
+	switch key := instance.Key.(type) {
+	case addrs.IntKey:
+		out.Key = &proto.BodyContent_Block_Instance_IntKey{IntKey: int64(key)}
+	case addrs.StringKey:
+		out.Key = &proto.BodyContent_Block_Instance_StringKey{StringKey: string(key)}
+	}
+	if instance.HasValue() {
+		val, marks, err := Value(instance.Value, cty.DynamicPseudoType)
+		if err != nil {
+			panic(fmt.Errorf("cannot marshal the instance value: %w", err))
+		}
+		out.Value = val
+		out.ValueMarks = marks
+	}
+	return out
+}
+
 // Rule converts tflint.Rule to proto.EmitIssue_Rule
 func Rule(rule tflint.Rule) *proto.EmitIssue_Rule {
 	if rule == nil {
@@ -162,6 +199,15 @@ func Pos(pos hcl.Pos) *proto.Range_Pos {
 	}
 }
 
+// TextEdits converts []tflint.TextEdit to []*proto.TextEdit
+func TextEdits(edits []tflint.TextEdit) []*proto.TextEdit {
+	ret := make([]*proto.TextEdit, len(edits))
+	for i, edit := range edits {
+		ret[i] = &proto.TextEdit{Range: Range(edit.Range), NewText: edit.NewText}
+	}
+	return ret
+}
+
 // Value converts cty.Value to msgpack and serialized value marks
 func Value(value cty.Value, ty cty.Type) ([]byte, []*proto.ValueMark, error) {
 	// Convert first to get the actual cty.Path
@@ -242,6 +288,22 @@ func Config(config *tflint.Config) *proto.ApplyGlobalConfig_Config {
 		DisabledByDefault: config.DisabledByDefault,
 		Only:              config.Only,
 		Fix:               config.Fix,
+		FormatMode:        FormatMode(config.FormatMode),
+		LogLevel:          config.LogLevel,
+	}
+}
+
+// FormatMode converts tflint.FormatMode to proto.ApplyGlobalConfig_FormatMode
+func FormatMode(mode tflint.FormatMode) proto.ApplyGlobalConfig_FormatMode {
+	switch mode {
+	case tflint.FormatModeFile:
+		return proto.ApplyGlobalConfig_FORMAT_MODE_FILE
+	case tflint.FormatModeChangedBlocks:
+		return proto.ApplyGlobalConfig_FORMAT_MODE_CHANGED_BLOCKS
+	case tflint.FormatModeNone:
+		return proto.ApplyGlobalConfig_FORMAT_MODE_NONE
+	default:
+		panic(fmt.Sprintf("invalid FormatMode: %s", mode))
 	}
 }
 
@@ -312,3 +374,26 @@ func Error(code codes.Code, err error) error {
 
 	return dt.Err()
 }
+
+// ErrorDetail converts error to proto.ErrorDetail.
+// This is used to return an error per item in a batch response instead of a gRPC error status.
+func ErrorDetail(err error) *proto.ErrorDetail {
+	if err == nil {
+		return nil
+	}
+
+	detail := &proto.ErrorDetail{Message: err.Error()}
+	if st, ok := status.FromError(err); ok {
+		detail.Message = st.Message()
+		for _, d := range st.Details() {
+			if ed, ok := d.(*proto.ErrorDetail); ok {
+				detail.Code = ed.Code
+			}
+		}
+	}
+	if errors.Is(err, tflint.ErrSensitive) {
+		detail.Code = proto.ErrorCode_ERROR_CODE_SENSITIVE
+	}
+
+	return detail
+}
@@ -4,3 +4,9 @@ import "github.com/terraform-linters/tflint-plugin-sdk/plugin/internal/plugin2ho
 
 // Server is the interface that the host should implement when a plugin communicates with the host.
 type Server = plugin2host.Server
+
+// Fix is an alternative fix of an issue passed to the Server.
+type Fix = plugin2host.Fix
+
+// ModuleSource is the source of the current module returned by the Server.
+type ModuleSource = plugin2host.ModuleSource
@@ -0,0 +1,16 @@
+package addrs
+
+// OutputValue is the address of an output value, in the context of the module
+// that is defining it.
+//
+// This is related to but separate from ModuleCallInstanceOutput, which represents
+// a module output from the perspective of its parent module. Outputs are
+// not valid as references, because they must always be accessed via their
+// containing module call.
+type OutputValue struct {
+	Name string
+}
+
+func (v OutputValue) String() string {
+	return "output." + v.Name
+}
@@ -0,0 +1,18 @@
+// Package graph provides a dependency graph of the objects declared in a module.
+//
+// The graph has nodes for resources, data sources, local values, input variables,
+// outputs and module calls. Edges are built from references found by
+// lang.ReferencesInExpr in every expression of the declaring block, including
+// meta-arguments like `count`, `for_each` and `depends_on`.
+//
+// This allows rules to answer questions like "which outputs depend on this data source?"
+// or "does this resource depend on itself via locals?" without walking expressions by hand:
+//
+//	g, err := graph.Build(runner)
+//	if err != nil {
+//		return err
+//	}
+//	for _, cycle := range g.Cycles() {
+//		// Report a cycle
+//	}
+package graph
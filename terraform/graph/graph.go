@@ -0,0 +1,408 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/terraform/addrs"
+	"github.com/terraform-linters/tflint-plugin-sdk/terraform/lang"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// Graph is a dependency graph of the objects declared in a module.
+type Graph struct {
+	nodes        map[string]*Node
+	dependencies map[string][]*Node
+	dependents   map[string][]*Node
+}
+
+// Node is an object declared in a module.
+type Node struct {
+	// Addr is the address of the object. This is one of addrs.Resource,
+	// addrs.LocalValue, addrs.InputVariable, addrs.OutputValue, and addrs.ModuleCall.
+	Addr fmt.Stringer
+	// DeclRange is the declaration range of the object.
+	// For local values, this is the range of the attribute, otherwise the range of the block header.
+	DeclRange hcl.Range
+	// References is a list of all references in the object, in source order.
+	// This includes references to objects that are not nodes of the graph, such as `count.index`.
+	References []*addrs.Reference
+}
+
+// String returns the address of the node.
+func (n *Node) String() string {
+	return n.Addr.String()
+}
+
+var moduleSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{
+		{Type: "resource", LabelNames: []string{"type", "name"}},
+		{Type: "data", LabelNames: []string{"type", "name"}},
+		{Type: "locals"},
+		{Type: "variable", LabelNames: []string{"name"}},
+		{Type: "output", LabelNames: []string{"name"}},
+		{Type: "module", LabelNames: []string{"name"}},
+	},
+}
+
+// Build builds a dependency graph from the files of the current module.
+func Build(runner tflint.Runner) (*Graph, error) {
+	files, err := runner.GetFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	g, diags := New(files)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return g, nil
+}
+
+// New builds a dependency graph from the passed files.
+// All files are expected to belong to the same module.
+// If the same object is declared more than once, the first declaration
+// in filename order is used.
+func New(files map[string]*hcl.File) (*Graph, hcl.Diagnostics) {
+	g := &Graph{
+		nodes:        map[string]*Node{},
+		dependencies: map[string][]*Node{},
+		dependents:   map[string][]*Node{},
+	}
+	var diags hcl.Diagnostics
+
+	filenames := make([]string, 0, len(files))
+	for name := range files {
+		filenames = append(filenames, name)
+	}
+	sort.Strings(filenames)
+
+	for _, name := range filenames {
+		content, _, contentDiags := files[name].Body.PartialContent(moduleSchema)
+		diags = diags.Extend(contentDiags)
+		if contentDiags.HasErrors() {
+			continue
+		}
+
+		for _, block := range content.Blocks {
+			switch block.Type {
+			case "resource":
+				diags = diags.Extend(g.addBlock(addrs.Resource{Mode: addrs.ManagedResourceMode, Type: block.Labels[0], Name: block.Labels[1]}, block))
+			case "data":
+				diags = diags.Extend(g.addBlock(addrs.Resource{Mode: addrs.DataResourceMode, Type: block.Labels[0], Name: block.Labels[1]}, block))
+			case "variable":
+				diags = diags.Extend(g.addBlock(addrs.InputVariable{Name: block.Labels[0]}, block))
+			case "output":
+				diags = diags.Extend(g.addBlock(addrs.OutputValue{Name: block.Labels[0]}, block))
+			case "module":
+				diags = diags.Extend(g.addBlock(addrs.ModuleCall{Name: block.Labels[0]}, block))
+			case "locals":
+				attrs, attrDiags := block.Body.JustAttributes()
+				diags = diags.Extend(attrDiags)
+				for _, attr := range sortAttributes(attrs) {
+					g.addNode(&Node{
+						Addr:       addrs.LocalValue{Name: attr.Name},
+						DeclRange:  attr.Range,
+						References: lang.ReferencesInExpr(attr.Expr),
+					})
+				}
+			}
+		}
+	}
+
+	for _, node := range g.nodes {
+		seen := map[string]bool{}
+		for _, ref := range node.References {
+			key := subjectKey(ref.Subject)
+			if key == "" || seen[key] {
+				continue
+			}
+			// Variables can refer to themselves in validation blocks, which is not a dependency.
+			if _, ok := node.Addr.(addrs.InputVariable); ok && key == node.String() {
+				continue
+			}
+			dep, exists := g.nodes[key]
+			if !exists {
+				continue
+			}
+			seen[key] = true
+
+			g.dependencies[node.String()] = append(g.dependencies[node.String()], dep)
+			g.dependents[key] = append(g.dependents[key], node)
+		}
+	}
+	for _, nodes := range g.dependencies {
+		sortNodes(nodes)
+	}
+	for _, nodes := range g.dependents {
+		sortNodes(nodes)
+	}
+
+	return g, diags
+}
+
+func (g *Graph) addBlock(addr fmt.Stringer, block *hcl.Block) hcl.Diagnostics {
+	refs, diags := bodyReferences(block.Body)
+	g.addNode(&Node{Addr: addr, DeclRange: block.DefRange, References: refs})
+	return diags
+}
+
+func (g *Graph) addNode(node *Node) {
+	if _, exists := g.nodes[node.String()]; exists {
+		return
+	}
+	g.nodes[node.String()] = node
+}
+
+// bodyReferences returns all references in the passed body, including nested blocks.
+func bodyReferences(body hcl.Body) ([]*addrs.Reference, hcl.Diagnostics) {
+	var refs []*addrs.Reference
+
+	if native, ok := body.(*hclsyntax.Body); ok {
+		for _, attr := range native.Attributes {
+			refs = append(refs, lang.ReferencesInExpr(attr.Expr)...)
+		}
+		for _, block := range native.Blocks {
+			blockRefs, _ := bodyReferences(block.Body)
+			refs = append(refs, blockRefs...)
+		}
+		sortReferences(refs)
+		return refs, nil
+	}
+
+	// In JSON syntax, nested blocks cannot be distinguished from attributes without a schema.
+	// However, references in nested blocks can be found by treating everything as attributes,
+	// because the expressions of JSON objects return variables of their nested values.
+	attrs, diags := body.JustAttributes()
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	for _, attr := range attrs {
+		refs = append(refs, lang.ReferencesInExpr(attr.Expr)...)
+	}
+	sortReferences(refs)
+	return refs, nil
+}
+
+// subjectKey returns the address of the node that the subject refers to.
+// Returns an empty string if the subject cannot be a node.
+func subjectKey(subject addrs.Referenceable) string {
+	switch s := subject.(type) {
+	case addrs.Resource:
+		return s.String()
+	case addrs.ResourceInstance:
+		return s.Resource.String()
+	case addrs.LocalValue:
+		return s.String()
+	case addrs.InputVariable:
+		return s.String()
+	case addrs.ModuleCall:
+		return s.String()
+	case addrs.ModuleCallInstance:
+		return s.Call.String()
+	case addrs.ModuleCallInstanceOutput:
+		return s.Call.Call.String()
+	default:
+		return ""
+	}
+}
+
+// Nodes returns all nodes in the graph, sorted by address.
+func (g *Graph) Nodes() []*Node {
+	nodes := make([]*Node, 0, len(g.nodes))
+	for _, node := range g.nodes {
+		nodes = append(nodes, node)
+	}
+	sortNodes(nodes)
+	return nodes
+}
+
+// Node returns the node of the given address, such as "aws_instance.main" and "local.name".
+// Returns nil if the node is not found.
+func (g *Graph) Node(addr string) *Node {
+	return g.nodes[addr]
+}
+
+// Dependencies returns nodes that the given node refers to directly.
+func (g *Graph) Dependencies(node *Node) []*Node {
+	return g.dependencies[node.String()]
+}
+
+// Dependents returns nodes that refer to the given node directly.
+func (g *Graph) Dependents(node *Node) []*Node {
+	return g.dependents[node.String()]
+}
+
+// TransitiveDependencies returns all nodes that the given node depends on directly or indirectly.
+// If the node depends on itself via a cycle, the result includes the node itself.
+func (g *Graph) TransitiveDependencies(node *Node) []*Node {
+	return g.reachable(node, g.dependencies)
+}
+
+// TransitiveDependents returns all nodes that depend on the given node directly or indirectly.
+// If the node depends on itself via a cycle, the result includes the node itself.
+func (g *Graph) TransitiveDependents(node *Node) []*Node {
+	return g.reachable(node, g.dependents)
+}
+
+func (g *Graph) reachable(node *Node, edges map[string][]*Node) []*Node {
+	visited := map[string]bool{}
+	ret := []*Node{}
+
+	stack := append([]*Node{}, edges[node.String()]...)
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[current.String()] {
+			continue
+		}
+		visited[current.String()] = true
+		ret = append(ret, current)
+		stack = append(stack, edges[current.String()]...)
+	}
+
+	sortNodes(ret)
+	return ret
+}
+
+// Cycles returns all dependency cycles in the graph.
+// Each cycle is a set of nodes that depend on each other, sorted by address.
+// A node that refers to itself is also reported as a cycle.
+func (g *Graph) Cycles() [][]*Node {
+	// Tarjan's strongly connected components algorithm
+	index := 0
+	indices := map[string]int{}
+	lowlinks := map[string]int{}
+	onStack := map[string]bool{}
+	stack := []*Node{}
+	cycles := [][]*Node{}
+
+	var strongConnect func(node *Node)
+	strongConnect = func(node *Node) {
+		key := node.String()
+		indices[key] = index
+		lowlinks[key] = index
+		index++
+		stack = append(stack, node)
+		onStack[key] = true
+
+		for _, dep := range g.dependencies[key] {
+			depKey := dep.String()
+			if _, visited := indices[depKey]; !visited {
+				strongConnect(dep)
+				lowlinks[key] = min(lowlinks[key], lowlinks[depKey])
+			} else if onStack[depKey] {
+				lowlinks[key] = min(lowlinks[key], indices[depKey])
+			}
+		}
+
+		if lowlinks[key] != indices[key] {
+			return
+		}
+
+		component := []*Node{}
+		for {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[last.String()] = false
+			component = append(component, last)
+			if last.String() == key {
+				break
+			}
+		}
+
+		if len(component) > 1 || g.dependsOnItself(node) {
+			sortNodes(component)
+			cycles = append(cycles, component)
+		}
+	}
+
+	for _, node := range g.Nodes() {
+		if _, visited := indices[node.String()]; !visited {
+			strongConnect(node)
+		}
+	}
+
+	sort.Slice(cycles, func(i, j int) bool {
+		return cycles[i][0].String() < cycles[j][0].String()
+	})
+	return cycles
+}
+
+func (g *Graph) dependsOnItself(node *Node) bool {
+	for _, dep := range g.dependencies[node.String()] {
+		if dep.String() == node.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// TopologicalOrder returns all nodes sorted so that each node comes after its dependencies.
+// Nodes that do not depend on each other are sorted by address.
+// If the graph has cycles, it returns an error.
+func (g *Graph) TopologicalOrder() ([]*Node, error) {
+	if cycles := g.Cycles(); len(cycles) > 0 {
+		names := make([]string, len(cycles[0]))
+		for i, node := range cycles[0] {
+			names[i] = node.String()
+		}
+		return nil, fmt.Errorf("dependency cycle found: %s", strings.Join(names, ", "))
+	}
+
+	remaining := map[string]int{}
+	ready := []*Node{}
+	for _, node := range g.Nodes() {
+		remaining[node.String()] = len(g.dependencies[node.String()])
+		if remaining[node.String()] == 0 {
+			ready = append(ready, node)
+		}
+	}
+
+	ret := make([]*Node, 0, len(g.nodes))
+	for len(ready) > 0 {
+		node := ready[0]
+		ready = ready[1:]
+		ret = append(ret, node)
+
+		released := false
+		for _, dependent := range g.dependents[node.String()] {
+			remaining[dependent.String()]--
+			if remaining[dependent.String()] == 0 {
+				ready = append(ready, dependent)
+				released = true
+			}
+		}
+		if released {
+			sortNodes(ready)
+		}
+	}
+
+	return ret, nil
+}
+
+func sortNodes(nodes []*Node) {
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].String() < nodes[j].String()
+	})
+}
+
+func sortAttributes(attrs hcl.Attributes) []*hcl.Attribute {
+	ret := make([]*hcl.Attribute, 0, len(attrs))
+	for _, attr := range attrs {
+		ret = append(ret, attr)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Range.Start.Byte < ret[j].Range.Start.Byte
+	})
+	return ret
+}
+
+func sortReferences(refs []*addrs.Reference) {
+	sort.SliceStable(refs, func(i, j int) bool {
+		return refs[i].SourceRange.Start.Byte < refs[j].SourceRange.Start.Byte
+	})
+}
@@ -0,0 +1,308 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func nodeNames(nodes []*Node) []string {
+	ret := make([]string, len(nodes))
+	for i, node := range nodes {
+		ret[i] = node.String()
+	}
+	return ret
+}
+
+func TestBuild(t *testing.T) {
+	tests := []struct {
+		name         string
+		files        map[string]string
+		nodes        []string
+		dependencies map[string][]string
+		dependents   map[string][]string
+	}{
+		{
+			name: "all kinds of nodes",
+			files: map[string]string{
+				"main.tf": `
+variable "env" {}
+
+locals {
+  name = "app-${var.env}"
+  tags = { Name = local.name }
+}
+
+data "aws_ami" "main" {
+  most_recent = true
+}
+
+resource "aws_instance" "main" {
+  count = 2
+  ami   = data.aws_ami.main.id
+  tags  = local.tags
+
+  ebs_block_device {
+    volume_size = module.sizes.volume_size
+  }
+}
+
+module "sizes" {
+  source = "./sizes"
+  env    = var.env
+}
+
+output "instance_ids" {
+  value      = aws_instance.main[*].id
+  depends_on = [module.sizes]
+}
+`,
+			},
+			nodes: []string{
+				"aws_instance.main",
+				"data.aws_ami.main",
+				"local.name",
+				"local.tags",
+				"module.sizes",
+				"output.instance_ids",
+				"var.env",
+			},
+			dependencies: map[string][]string{
+				"aws_instance.main":   {"data.aws_ami.main", "local.tags", "module.sizes"},
+				"local.name":          {"var.env"},
+				"local.tags":          {"local.name"},
+				"module.sizes":        {"var.env"},
+				"output.instance_ids": {"aws_instance.main", "module.sizes"},
+			},
+			dependents: map[string][]string{
+				"aws_instance.main": {"output.instance_ids"},
+				"data.aws_ami.main": {"aws_instance.main"},
+				"local.name":        {"local.tags"},
+				"local.tags":        {"aws_instance.main"},
+				"module.sizes":      {"aws_instance.main", "output.instance_ids"},
+				"var.env":           {"local.name", "module.sizes"},
+			},
+		},
+		{
+			name: "variable validation",
+			files: map[string]string{
+				"main.tf": `
+variable "env" {
+  validation {
+    condition     = contains(["dev", "prod"], var.env)
+    error_message = "Invalid env."
+  }
+}`,
+			},
+			nodes:        []string{"var.env"},
+			dependencies: map[string][]string{},
+			dependents:   map[string][]string{},
+		},
+		{
+			name: "JSON syntax",
+			files: map[string]string{
+				"main.tf.json": `
+{
+  "locals": {
+    "name": "${var.env}"
+  },
+  "variable": {
+    "env": {}
+  },
+  "resource": {
+    "aws_instance": {
+      "main": {
+        "ebs_block_device": {
+          "volume_size": "${local.name}"
+        }
+      }
+    }
+  }
+}`,
+			},
+			nodes: []string{"aws_instance.main", "local.name", "var.env"},
+			dependencies: map[string][]string{
+				"aws_instance.main": {"local.name"},
+				"local.name":        {"var.env"},
+			},
+			dependents: map[string][]string{
+				"local.name": {"aws_instance.main"},
+				"var.env":    {"local.name"},
+			},
+		},
+		{
+			name: "multiple files",
+			files: map[string]string{
+				"main.tf": `
+resource "aws_instance" "main" {
+  instance_type = var.instance_type
+}`,
+				"variables.tf": `
+variable "instance_type" {}`,
+			},
+			nodes: []string{"aws_instance.main", "var.instance_type"},
+			dependencies: map[string][]string{
+				"aws_instance.main": {"var.instance_type"},
+			},
+			dependents: map[string][]string{
+				"var.instance_type": {"aws_instance.main"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, test.files)
+
+			g, err := Build(runner)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(test.nodes, nodeNames(g.Nodes())); diff != "" {
+				t.Errorf("nodes: %s", diff)
+			}
+
+			dependencies := map[string][]string{}
+			dependents := map[string][]string{}
+			for _, node := range g.Nodes() {
+				if deps := g.Dependencies(node); len(deps) > 0 {
+					dependencies[node.String()] = nodeNames(deps)
+				}
+				if deps := g.Dependents(node); len(deps) > 0 {
+					dependents[node.String()] = nodeNames(deps)
+				}
+			}
+			if diff := cmp.Diff(test.dependencies, dependencies); diff != "" {
+				t.Errorf("dependencies: %s", diff)
+			}
+			if diff := cmp.Diff(test.dependents, dependents); diff != "" {
+				t.Errorf("dependents: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTransitiveDependencies(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{
+		"main.tf": `
+data "aws_ami" "main" {}
+
+locals {
+  ami = data.aws_ami.main.id
+}
+
+resource "aws_instance" "main" {
+  ami = local.ami
+}
+
+output "instance_id" {
+  value = aws_instance.main.id
+}`,
+	})
+
+	g, err := Build(runner)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := nodeNames(g.TransitiveDependencies(g.Node("output.instance_id")))
+	if diff := cmp.Diff([]string{"aws_instance.main", "data.aws_ami.main", "local.ami"}, got); diff != "" {
+		t.Errorf("dependencies: %s", diff)
+	}
+
+	got = nodeNames(g.TransitiveDependents(g.Node("data.aws_ami.main")))
+	if diff := cmp.Diff([]string{"aws_instance.main", "local.ami", "output.instance_id"}, got); diff != "" {
+		t.Errorf("dependents: %s", diff)
+	}
+}
+
+func TestCycles_TopologicalOrder(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		cycles [][]string
+		order  []string
+		err    string
+	}{
+		{
+			name: "no cycles",
+			source: `
+variable "env" {}
+
+locals {
+  name = var.env
+}
+
+resource "aws_instance" "main" {
+  tags = { Name = local.name }
+}
+
+resource "aws_eip" "main" {
+  instance = aws_instance.main.id
+}`,
+			cycles: [][]string{},
+			order:  []string{"var.env", "local.name", "aws_instance.main", "aws_eip.main"},
+		},
+		{
+			name: "resource depends on itself via locals",
+			source: `
+locals {
+  id = aws_instance.main.id
+}
+
+resource "aws_instance" "main" {
+  tags = { Self = local.id }
+}
+
+resource "aws_eip" "main" {
+  instance = aws_instance.main.id
+}`,
+			cycles: [][]string{{"aws_instance.main", "local.id"}},
+			err:    "dependency cycle found: aws_instance.main, local.id",
+		},
+		{
+			name: "self reference",
+			source: `
+resource "aws_instance" "main" {
+  tags = { Name = aws_instance.main.id }
+}`,
+			cycles: [][]string{{"aws_instance.main"}},
+			err:    "dependency cycle found: aws_instance.main",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.source})
+
+			g, err := Build(runner)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cycles := [][]string{}
+			for _, cycle := range g.Cycles() {
+				cycles = append(cycles, nodeNames(cycle))
+			}
+			if diff := cmp.Diff(test.cycles, cycles); diff != "" {
+				t.Errorf("cycles: %s", diff)
+			}
+
+			order, err := g.TopologicalOrder()
+			if err != nil {
+				if err.Error() != test.err {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if test.err != "" {
+				t.Fatalf("expected error %q, but got nil", test.err)
+			}
+			if diff := cmp.Diff(test.order, nodeNames(order)); diff != "" {
+				t.Errorf("order: %s", diff)
+			}
+		})
+	}
+}
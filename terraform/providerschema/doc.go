@@ -0,0 +1,22 @@
+// Package providerschema loads provider schemas output by `terraform providers schema -json`.
+//
+// Rules can validate arguments against the actual provider schema without maintaining
+// hand-written schemas or code generators. The loaded schema is converted to hclext.BodySchema,
+// so it can be passed to GetResourceContent as is:
+//
+//	schemas, err := providerschema.Load("schema.json")
+//	if err != nil {
+//		return err
+//	}
+//	schema, exists := schemas.Resource("aws_instance")
+//	if !exists {
+//		return fmt.Errorf("aws_instance is not found")
+//	}
+//	resources, err := runner.GetResourceContent("aws_instance", schema.Block.BodySchema(), nil)
+//
+// It also provides lookups for attributes and blocks, like whether an attribute is deprecated:
+//
+//	if schema.Block.IsDeprecated("ebs_block_device", "iops") {
+//		// Report a deprecated attribute
+//	}
+package providerschema
@@ -0,0 +1,313 @@
+package providerschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Schemas is the output of `terraform providers schema -json`.
+type Schemas struct {
+	FormatVersion string `json:"format_version"`
+	// ProviderSchemas is a map of provider schemas keyed by the provider source address,
+	// like "registry.terraform.io/hashicorp/aws".
+	ProviderSchemas map[string]*ProviderSchema `json:"provider_schemas"`
+}
+
+// ProviderSchema is the schema of a provider, and resources and data sources it provides.
+type ProviderSchema struct {
+	Provider          *Schema            `json:"provider"`
+	ResourceSchemas   map[string]*Schema `json:"resource_schemas"`
+	DataSourceSchemas map[string]*Schema `json:"data_source_schemas"`
+}
+
+// Schema is a versioned schema of a provider configuration, resource, or data source.
+type Schema struct {
+	Version int64  `json:"version"`
+	Block   *Block `json:"block"`
+}
+
+// Block is a schema of a configuration block.
+type Block struct {
+	Attributes  map[string]*Attribute   `json:"attributes"`
+	BlockTypes  map[string]*NestedBlock `json:"block_types"`
+	Description string                  `json:"description"`
+	Deprecated  bool                    `json:"deprecated"`
+}
+
+// Attribute is a schema of an attribute. Either Type or NestedType is set.
+type Attribute struct {
+	Type        cty.Type    `json:"type"`
+	NestedType  *NestedType `json:"nested_type"`
+	Description string      `json:"description"`
+	Required    bool        `json:"required"`
+	Optional    bool        `json:"optional"`
+	Computed    bool        `json:"computed"`
+	Sensitive   bool        `json:"sensitive"`
+	Deprecated  bool        `json:"deprecated"`
+}
+
+// NestedType is a schema of an attribute that has nested attributes.
+type NestedType struct {
+	Attributes  map[string]*Attribute `json:"attributes"`
+	NestingMode NestingMode           `json:"nesting_mode"`
+	MinItems    uint64                `json:"min_items"`
+	MaxItems    uint64                `json:"max_items"`
+}
+
+// NestedBlock is a schema of a nested block.
+type NestedBlock struct {
+	Block       *Block      `json:"block"`
+	NestingMode NestingMode `json:"nesting_mode"`
+	MinItems    uint64      `json:"min_items"`
+	MaxItems    uint64      `json:"max_items"`
+}
+
+// NestingMode is how many nested blocks or objects are allowed, and how they are represented as a value.
+type NestingMode string
+
+const (
+	// NestingSingle allows a single block or object.
+	NestingSingle NestingMode = "single"
+	// NestingGroup is similar to NestingSingle, but the value is never null.
+	NestingGroup NestingMode = "group"
+	// NestingList allows blocks or objects as a list.
+	NestingList NestingMode = "list"
+	// NestingSet allows blocks or objects as a set.
+	NestingSet NestingMode = "set"
+	// NestingMap allows blocks or objects as a map. Blocks have a label as the key.
+	NestingMap NestingMode = "map"
+)
+
+// Load reads provider schemas from the given file.
+func Load(path string) (*Schemas, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(src)
+}
+
+// Parse parses the output of `terraform providers schema -json`.
+func Parse(src []byte) (*Schemas, error) {
+	var schemas Schemas
+	if err := json.Unmarshal(src, &schemas); err != nil {
+		return nil, fmt.Errorf("failed to parse provider schemas: %w", err)
+	}
+	if schemas.ProviderSchemas == nil {
+		schemas.ProviderSchemas = map[string]*ProviderSchema{}
+	}
+	return &schemas, nil
+}
+
+// Provider returns the schema of the given provider. The name can be the full source address
+// like "registry.terraform.io/hashicorp/aws", a source address without hostname like
+// "hashicorp/aws", or a type like "aws". If multiple providers have the same type,
+// the first one in the order of source addresses is returned.
+func (s *Schemas) Provider(name string) (*ProviderSchema, bool) {
+	for _, addr := range s.sourceAddrs() {
+		if addr == name || strings.HasSuffix(addr, "/"+name) {
+			return s.ProviderSchemas[addr], true
+		}
+	}
+	return nil, false
+}
+
+// Resource returns the schema of the given resource type from any provider.
+func (s *Schemas) Resource(resourceType string) (*Schema, bool) {
+	for _, addr := range s.sourceAddrs() {
+		if schema, exists := s.ProviderSchemas[addr].ResourceSchemas[resourceType]; exists {
+			return schema, true
+		}
+	}
+	return nil, false
+}
+
+// DataSource returns the schema of the given data source type from any provider.
+func (s *Schemas) DataSource(dataSourceType string) (*Schema, bool) {
+	for _, addr := range s.sourceAddrs() {
+		if schema, exists := s.ProviderSchemas[addr].DataSourceSchemas[dataSourceType]; exists {
+			return schema, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Schemas) sourceAddrs() []string {
+	addrs := make([]string, 0, len(s.ProviderSchemas))
+	for addr := range s.ProviderSchemas {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	return addrs
+}
+
+// BodySchema returns hclext.BodySchema that retrieves all attributes and nested blocks of the block.
+//
+// Attributes have the types of the provider schema, so Content and PartialContent return
+// diagnostics for literal values of incompatible types. Attributes required by the provider
+// schema are also required. Note that meta-arguments like `count` and `lifecycle` are not included.
+func (b *Block) BodySchema() *hclext.BodySchema {
+	schema := &hclext.BodySchema{}
+	if b == nil {
+		return schema
+	}
+
+	for _, name := range sortedKeys(b.Attributes) {
+		attr := b.Attributes[name]
+		schema.Attributes = append(schema.Attributes, hclext.AttributeSchema{
+			Name:     name,
+			Required: attr.Required,
+			Type:     attr.ImpliedType(),
+		})
+	}
+
+	for _, name := range sortedKeys(b.BlockTypes) {
+		nested := b.BlockTypes[name]
+
+		var labelNames []string
+		if nested.NestingMode == NestingMap {
+			labelNames = []string{"key"}
+		}
+		schema.Blocks = append(schema.Blocks, hclext.BlockSchema{
+			Type:       name,
+			LabelNames: labelNames,
+			Body:       nested.Block.BodySchema(),
+		})
+	}
+
+	return schema
+}
+
+// Attribute returns the attribute at the given path. The path consists of names of nested blocks
+// or attributes with nested types, and the name of the attribute at last. For example,
+// ("ebs_block_device", "volume_size") returns the "volume_size" attribute in "ebs_block_device" blocks.
+func (b *Block) Attribute(path ...string) (*Attribute, bool) {
+	if b == nil || len(path) == 0 {
+		return nil, false
+	}
+	name, rest := path[0], path[1:]
+
+	if len(rest) == 0 {
+		attr, exists := b.Attributes[name]
+		return attr, exists
+	}
+	if nested, exists := b.BlockTypes[name]; exists {
+		return nested.Block.Attribute(rest...)
+	}
+	if attr, exists := b.Attributes[name]; exists && attr.NestedType != nil {
+		return attr.NestedType.attribute(rest)
+	}
+	return nil, false
+}
+
+func (t *NestedType) attribute(path []string) (*Attribute, bool) {
+	attr, exists := t.Attributes[path[0]]
+	if !exists {
+		return nil, false
+	}
+	if len(path) == 1 {
+		return attr, true
+	}
+	if attr.NestedType == nil {
+		return nil, false
+	}
+	return attr.NestedType.attribute(path[1:])
+}
+
+// NestedBlock returns the nested block at the given path. For example,
+// ("rule", "statement") returns "statement" blocks in "rule" blocks.
+func (b *Block) NestedBlock(path ...string) (*NestedBlock, bool) {
+	if b == nil || len(path) == 0 {
+		return nil, false
+	}
+	nested, exists := b.BlockTypes[path[0]]
+	if !exists || len(path) == 1 {
+		return nested, exists
+	}
+	return nested.Block.NestedBlock(path[1:]...)
+}
+
+// IsDeprecated returns true if the attribute or the nested block at the given path is deprecated.
+// Attributes and blocks in deprecated blocks or attributes are also deprecated.
+// It returns false if the path does not exist.
+func (b *Block) IsDeprecated(path ...string) bool {
+	if _, exists := b.Attribute(path...); !exists {
+		if _, exists := b.NestedBlock(path...); !exists {
+			return false
+		}
+	}
+	return b.isDeprecated(path)
+}
+
+func (b *Block) isDeprecated(path []string) bool {
+	if b.Deprecated {
+		return true
+	}
+	if len(path) == 0 {
+		return false
+	}
+	if nested, exists := b.BlockTypes[path[0]]; exists {
+		return nested.Block.isDeprecated(path[1:])
+	}
+	return b.Attributes[path[0]].isDeprecated(path[1:])
+}
+
+func (a *Attribute) isDeprecated(path []string) bool {
+	if a.Deprecated {
+		return true
+	}
+	if len(path) == 0 {
+		return false
+	}
+	return a.NestedType.Attributes[path[0]].isDeprecated(path[1:])
+}
+
+// ImpliedType returns the type of the attribute value.
+// For attributes with nested types, it returns the type of objects built from the nested attributes.
+func (a *Attribute) ImpliedType() cty.Type {
+	if a.NestedType == nil {
+		return a.Type
+	}
+	return a.NestedType.ImpliedType()
+}
+
+// ImpliedType returns the type of the value built from the nested attributes and the nesting mode.
+// Optional attributes are optional attributes of the object type.
+func (t *NestedType) ImpliedType() cty.Type {
+	attrTypes := map[string]cty.Type{}
+	var optional []string
+	for name, attr := range t.Attributes {
+		attrTypes[name] = attr.ImpliedType()
+		if !attr.Required {
+			optional = append(optional, name)
+		}
+	}
+	sort.Strings(optional)
+	obj := cty.ObjectWithOptionalAttrs(attrTypes, optional)
+
+	switch t.NestingMode {
+	case NestingList:
+		return cty.List(obj)
+	case NestingSet:
+		return cty.Set(obj)
+	case NestingMap:
+		return cty.Map(obj)
+	default:
+		return obj
+	}
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,333 @@
+package providerschema
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const testSchemas = `{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/aws": {
+      "provider": {
+        "version": 0,
+        "block": {
+          "attributes": {
+            "region": {"type": "string", "optional": true}
+          }
+        }
+      },
+      "resource_schemas": {
+        "aws_instance": {
+          "version": 1,
+          "block": {
+            "attributes": {
+              "ami": {"type": "string", "required": true},
+              "id": {"type": "string", "computed": true},
+              "instance_type": {"type": "string", "optional": true, "computed": true},
+              "tags": {"type": ["map", "string"], "optional": true},
+              "cpu_core_count": {"type": "number", "optional": true, "deprecated": true}
+            },
+            "block_types": {
+              "ebs_block_device": {
+                "nesting_mode": "set",
+                "block": {
+                  "attributes": {
+                    "volume_size": {"type": "number", "optional": true},
+                    "iops": {"type": "number", "optional": true}
+                  }
+                }
+              },
+              "network_interface": {
+                "nesting_mode": "list",
+                "block": {
+                  "attributes": {
+                    "device_index": {"type": "number", "required": true}
+                  },
+                  "deprecated": true
+                }
+              },
+              "settings": {
+                "nesting_mode": "map",
+                "block": {
+                  "attributes": {
+                    "value": {"type": "string", "optional": true}
+                  }
+                }
+              }
+            }
+          }
+        },
+        "aws_s3_bucket_lifecycle_configuration": {
+          "version": 0,
+          "block": {
+            "attributes": {
+              "rule": {
+                "nested_type": {
+                  "nesting_mode": "list",
+                  "attributes": {
+                    "id": {"type": "string", "required": true},
+                    "expiration": {
+                      "nested_type": {
+                        "nesting_mode": "single",
+                        "attributes": {
+                          "days": {"type": "number", "optional": true, "deprecated": true}
+                        }
+                      },
+                      "optional": true
+                    }
+                  }
+                },
+                "optional": true
+              }
+            }
+          }
+        }
+      },
+      "data_source_schemas": {
+        "aws_ami": {
+          "version": 0,
+          "block": {
+            "attributes": {
+              "owners": {"type": ["list", "string"], "optional": true}
+            }
+          }
+        }
+      }
+    },
+    "registry.terraform.io/hashicorp/google": {
+      "provider": {
+        "version": 0,
+        "block": {
+          "attributes": {
+            "project": {"type": "string", "optional": true}
+          }
+        }
+      }
+    }
+  }
+}`
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schema.json")
+	if err := os.WriteFile(path, []byte(testSchemas), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	schemas, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"registry.terraform.io/hashicorp/aws", "hashicorp/aws", "aws"} {
+		provider, exists := schemas.Provider(name)
+		if !exists {
+			t.Fatalf("provider %s is not found", name)
+		}
+		if _, exists := provider.Provider.Block.Attributes["region"]; !exists {
+			t.Errorf("provider %s is not the aws provider", name)
+		}
+	}
+	if _, exists := schemas.Provider("azurerm"); exists {
+		t.Error("azurerm provider should not be found")
+	}
+
+	if _, exists := schemas.Resource("aws_instance"); !exists {
+		t.Error("aws_instance resource is not found")
+	}
+	if _, exists := schemas.Resource("aws_ami"); exists {
+		t.Error("aws_ami resource should not be found")
+	}
+	if _, exists := schemas.DataSource("aws_ami"); !exists {
+		t.Error("aws_ami data source is not found")
+	}
+
+	if _, err := Parse([]byte(`{"provider_schemas": {"aws": {"provider": {"block": {"attributes": {"region": {"type": "unknown"}}}}}}}`)); err == nil {
+		t.Error("expected an error for an invalid type, but got nil")
+	}
+}
+
+func TestBodySchema(t *testing.T) {
+	schemas, err := Parse([]byte(testSchemas))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		resource string
+		want     *hclext.BodySchema
+	}{
+		{
+			name:     "attributes and blocks",
+			resource: "aws_instance",
+			want: &hclext.BodySchema{
+				Attributes: []hclext.AttributeSchema{
+					{Name: "ami", Required: true, Type: cty.String},
+					{Name: "cpu_core_count", Type: cty.Number},
+					{Name: "id", Type: cty.String},
+					{Name: "instance_type", Type: cty.String},
+					{Name: "tags", Type: cty.Map(cty.String)},
+				},
+				Blocks: []hclext.BlockSchema{
+					{
+						Type: "ebs_block_device",
+						Body: &hclext.BodySchema{
+							Attributes: []hclext.AttributeSchema{
+								{Name: "iops", Type: cty.Number},
+								{Name: "volume_size", Type: cty.Number},
+							},
+						},
+					},
+					{
+						Type: "network_interface",
+						Body: &hclext.BodySchema{
+							Attributes: []hclext.AttributeSchema{
+								{Name: "device_index", Required: true, Type: cty.Number},
+							},
+						},
+					},
+					{
+						Type:       "settings",
+						LabelNames: []string{"key"},
+						Body: &hclext.BodySchema{
+							Attributes: []hclext.AttributeSchema{
+								{Name: "value", Type: cty.String},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:     "nested attributes",
+			resource: "aws_s3_bucket_lifecycle_configuration",
+			want: &hclext.BodySchema{
+				Attributes: []hclext.AttributeSchema{
+					{
+						Name: "rule",
+						Type: cty.List(cty.ObjectWithOptionalAttrs(map[string]cty.Type{
+							"id": cty.String,
+							"expiration": cty.ObjectWithOptionalAttrs(map[string]cty.Type{
+								"days": cty.Number,
+							}, []string{"days"}),
+						}, []string{"expiration"})),
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schema, exists := schemas.Resource(test.resource)
+			if !exists {
+				t.Fatalf("%s is not found", test.resource)
+			}
+
+			got := schema.Block.BodySchema()
+			if diff := cmp.Diff(test.want, got, cmp.Comparer(func(x, y cty.Type) bool { return x.Equals(y) })); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	schemas, err := Parse([]byte(testSchemas))
+	if err != nil {
+		t.Fatal(err)
+	}
+	instance, _ := schemas.Resource("aws_instance")
+	lifecycle, _ := schemas.Resource("aws_s3_bucket_lifecycle_configuration")
+
+	tests := []struct {
+		name       string
+		block      *Block
+		path       []string
+		attribute  bool
+		nested     bool
+		deprecated bool
+	}{
+		{
+			name:      "attribute",
+			block:     instance.Block,
+			path:      []string{"ami"},
+			attribute: true,
+		},
+		{
+			name:       "deprecated attribute",
+			block:      instance.Block,
+			path:       []string{"cpu_core_count"},
+			attribute:  true,
+			deprecated: true,
+		},
+		{
+			name:      "attribute in nested block",
+			block:     instance.Block,
+			path:      []string{"ebs_block_device", "volume_size"},
+			attribute: true,
+		},
+		{
+			name:       "deprecated nested block",
+			block:      instance.Block,
+			path:       []string{"network_interface"},
+			nested:     true,
+			deprecated: true,
+		},
+		{
+			name:       "attribute in deprecated nested block",
+			block:      instance.Block,
+			path:       []string{"network_interface", "device_index"},
+			attribute:  true,
+			deprecated: true,
+		},
+		{
+			name:  "missing attribute in deprecated nested block",
+			block: instance.Block,
+			path:  []string{"network_interface", "unknown"},
+		},
+		{
+			name:      "attribute with nested type",
+			block:     lifecycle.Block,
+			path:      []string{"rule"},
+			attribute: true,
+		},
+		{
+			name:       "deprecated nested attribute",
+			block:      lifecycle.Block,
+			path:       []string{"rule", "expiration", "days"},
+			attribute:  true,
+			deprecated: true,
+		},
+		{
+			name:  "attribute in attribute without nested type",
+			block: lifecycle.Block,
+			path:  []string{"rule", "id", "foo"},
+		},
+		{
+			name:  "empty path",
+			block: instance.Block,
+			path:  []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, exists := test.block.Attribute(test.path...); exists != test.attribute {
+				t.Errorf("Attribute: want %t, got %t", test.attribute, exists)
+			}
+			if _, exists := test.block.NestedBlock(test.path...); exists != test.nested {
+				t.Errorf("NestedBlock: want %t, got %t", test.nested, exists)
+			}
+			if got := test.block.IsDeprecated(test.path...); got != test.deprecated {
+				t.Errorf("IsDeprecated: want %t, got %t", test.deprecated, got)
+			}
+		})
+	}
+}
@@ -6,6 +6,9 @@ type Config struct {
 	DisabledByDefault bool
 	Only              []string
 	Fix               bool
+	FormatMode        FormatMode
+	// LogLevel overrides the log level of the plugin set by TFLINT_LOG if not empty.
+	LogLevel string
 }
 
 // RuleConfig is a TFLint's rule configuration.
@@ -13,3 +16,19 @@ type RuleConfig struct {
 	Name    string
 	Enabled bool
 }
+
+// FormatMode represents how files changed by autofix are formatted.
+//
+//go:generate stringer -type=FormatMode
+type FormatMode int32
+
+const (
+	// FormatModeFile formats the entire changed files. The default is this behavior.
+	FormatModeFile FormatMode = iota
+	// FormatModeChangedBlocks formats only the top-level blocks and attributes touched by fixes.
+	// This is useful for avoiding unrelated changes in files that are not formatted by `terraform fmt`.
+	// Files in JSON syntax are not formatted in this mode, since fixes follow the indentation of the surrounding members.
+	FormatModeChangedBlocks
+	// FormatModeNone does not format changed files.
+	FormatModeNone
+)
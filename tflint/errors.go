@@ -25,4 +25,8 @@ var (
 	// This can prevent the issue from being marked as fixable by returning it
 	// in FixFunc when autofix cannot be implemented, such as with JSON syntax.
 	ErrFixNotSupported = errors.New("autofix is not supported")
+	// ErrFixConflict is an error returned from Fixer when the rewrite range overlaps with
+	// the fix of a previous issue. In this case, the previous fix takes precedence and
+	// the issue will not be fixed. You don't need to handle this error in FixFunc.
+	ErrFixConflict = errors.New("autofix conflicts with a previous fix")
 )
@@ -1,6 +1,7 @@
 package tflint
 
 import (
+	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/hcl/v2"
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/terraform/addrs"
@@ -110,6 +111,9 @@ type Runner interface {
 
 	// GetModuleContent retrieves the content of the module based on the passed schema.
 	// GetResourceContent/GetProviderContent are syntactic sugar for GetModuleContent, which you can use to access other structures.
+	//
+	// Blocks, including nested blocks, are ordered by filename and position in the file.
+	// Attributes are a map, so use hclext.Attributes.Sorted to iterate over them in the same order.
 	GetModuleContent(schema *hclext.BodySchema, option *GetModuleContentOption) (*hclext.BodyContent, error)
 
 	// GetFile returns the hcl.File object.
@@ -190,25 +194,26 @@ type Runner interface {
 	// ```
 	//
 	// However, if the target is cty.Value, these errors will not be returned.
-	//
-	// Here are the types that can be passed as the target: string, int, bool, []string,
-	// []int, []bool, map[string]string, map[string]int, map[string]bool, and cty.Value.
+	// If you want to check the known parts of a partially unknown or sensitive value,
+	// pass tflint.PartialValue as the target. See PartialValue for details.
+	//
+	// The type of the value is inferred from the target. Here are the types that can be
+	// passed as the target: bool, string, numbers (int, int64, float64, big.Float, etc.),
+	// time.Duration (from strings like "5m"), slices and maps of these types, structs
+	// with cty tags, cty.Value, and tflint.PartialValue. Pointer fields of structs are
+	// treated as optional attributes, and nil is assigned for null values.
 	// Passing any other type will result in a panic, but you can make an exception by
 	// passing wantType as an option.
 	//
 	// ```
-	// type complexVal struct {
-	//   Key     string `cty:"key"`
-	//   Enabled bool   `cty:"enabled"`
+	// type ingress struct {
+	//   FromPort int   `cty:"from_port"`
+	//   ToPort   int   `cty:"to_port"`
+	//   Self     *bool `cty:"self"`
 	// }
 	//
-	// wantType := cty.List(cty.Object(map[string]cty.Type{
-	//   "key":     cty.String,
-	//   "enabled": cty.Bool,
-	// }))
-	//
-	// var complexVals []complexVal
-	// runner.EvaluateExpr(expr, &compleVals, &tflint.EvaluateExprOption{WantType: &wantType})
+	// var ingresses []ingress
+	// runner.EvaluateExpr(expr, &ingresses, nil)
 	// ```
 	//
 	// For functions (callbacks), the assigned value is used as an argument to execute
@@ -225,6 +230,55 @@ type Runner interface {
 	// ```
 	EvaluateExpr(expr hcl.Expression, target interface{}, option *EvaluateExprOption) error
 
+	// EvaluateExprs evaluates multiple expressions at once. Each request is evaluated in the same way
+	// as EvaluateExpr, but the overhead of communicating with the host is reduced to a single round trip.
+	// This is useful when evaluating a large number of expressions, like tags in all resources.
+	//
+	// The returned errors correspond to the requests in the same order, and each error is nil
+	// if the evaluation succeeds. An error that affects all requests, like a communication failure,
+	// is returned as the second return value.
+	//
+	// ```
+	// tags := make([]map[string]string, len(resources.Blocks))
+	// requests := make([]tflint.EvaluateExprRequest, len(resources.Blocks))
+	// for i, resource := range resources.Blocks {
+	//   requests[i] = tflint.EvaluateExprRequest{Expr: resource.Body.Attributes["tags"].Expr, Target: &tags[i]}
+	// }
+	//
+	// errs, err := runner.EvaluateExprs(requests)
+	// if err != nil {
+	//   return err
+	// }
+	// for i, err := range errs {
+	//   if err != nil {
+	//     // Handle the error for requests[i]
+	//   }
+	// }
+	// ```
+	EvaluateExprs(requests []EvaluateExprRequest) ([]error, error)
+
+	// TraceValue traces the value of an expression back through `local.*` and `var.*` references
+	// to the expressions where the value originates. This is useful to point out the literal
+	// that caused a bad value, which is often in a `locals` block or a variable default:
+	//
+	// ```
+	// source, err := runner.TraceValue(attr.Expr)
+	// if err != nil {
+	//   return err
+	// }
+	// for _, origin := range source.Origins() {
+	//   if origin.Expr != nil {
+	//     // origin.Expr.Range() is the range of the originating expression
+	//   }
+	// }
+	// ```
+	//
+	// The returned tree has the passed expression as its root, and each referenced local value
+	// or input variable as its children. Only the current module is traced, so values passed
+	// from outside the module, such as tfvars and module arguments, are not traced.
+	// For input variables, the trace stops at the `default` expression.
+	TraceValue(expr hcl.Expression) (*ValueSource, error)
+
 	// EmitIssue sends an issue to TFLint. You need to pass the message of the issue and the range.
 	EmitIssue(rule Rule, message string, issueRange hcl.Range) error
 
@@ -240,10 +294,52 @@ type Runner interface {
 	// you can return tflint.ErrFixNotSupported from the fix function.
 	// In this case, the issue will not be marked as fixable and the fix will not be applied.
 	//
+	// Issues in local child modules (e.g. source = "./modules/vpc") can also be fixed.
+	// Issues in remote modules, such as registry modules, are never marked as fixable.
+	//
 	// As a best practice for autofix, we recommend minimizing the amount of code changed at once.
-	// If fixes for the same range conflict within the same rule, Fixer will return an error.
+	// If a fix overlaps with the fix of a previous issue, the previous fix takes precedence.
+	// The conflicting fix is not applied and the issue is not marked as fixable.
 	EmitIssueWithFix(rule Rule, message string, issueRange hcl.Range, fixFunc func(f Fixer) error) error
 
+	// EmitIssueWithFixes is similar to EmitIssueWithFix, but it supports multiple alternative fixes.
+	// This is useful when there are several ways to resolve an issue, e.g. removing a deprecated
+	// attribute or replacing it with a new one. Editor integrations can offer them as choices.
+	//
+	// ```
+	// runner.EmitIssueWithFixes(rule, "`foo` is deprecated", attr.Range, []tflint.Fix{
+	//   {
+	//     Name: "Replace with `bar`",
+	//     Func: func(f tflint.Fixer) error {
+	//       return f.ReplaceText(attr.NameRange, "bar")
+	//     },
+	//   },
+	//   {
+	//     Name: "Remove `foo`",
+	//     Func: func(f tflint.Fixer) error {
+	//       return f.RemoveAttribute(attr)
+	//     },
+	//   },
+	// })
+	// ```
+	//
+	// Each fix is invoked independently, and the changes are sent to TFLint as separate edit sets.
+	// Fixes that return tflint.ErrFixNotSupported are not offered. The first offered fix is the default,
+	// and TFLint will apply it when the --fix option is specified.
+	// If no fixes are offered, the issue will not be marked as fixable.
+	EmitIssueWithFixes(rule Rule, message string, issueRange hcl.Range, fixes []Fix) error
+
+	// Logger returns a logger for the rule currently being checked.
+	// Logs are tagged with the rule name, the module path, and the check ID that identifies
+	// the Check request from TFLint, so you can tell which rule and module emitted them:
+	//
+	// ```
+	// runner.Logger().Debug("instance type is unknown", "range", attr.Expr.Range())
+	// ```
+	//
+	// The log level follows TFLINT_LOG or the level set by TFLint. See the logger package for details.
+	Logger() hclog.Logger
+
 	// EnsureNoError is a helper for error handling. Depending on the type of error generated by EvaluateExpr,
 	// determine whether to exit, skip, or continue. If it is continued, the passed function will be executed.
 	//
@@ -256,6 +352,7 @@ type Runner interface {
 type Fixer interface {
 	// ReplaceText rewrites the given range of source code to a new text.
 	// If the range is overlapped with a previous rewrite range, it returns an error.
+	// Newlines in the text are converted to the newline sequence (LF or CRLF) used in the file.
 	//
 	// Either string or tflint.TextNode is valid as an argument.
 	// TextNode can be obtained with fixer.TextAt(range).
@@ -279,21 +376,73 @@ type Fixer interface {
 	// RemoveAttribute removes the given attribute from the source code.
 	// The difference from Remove is that it removes the attribute
 	// and the associated newlines, indentations, and comments.
-	// This only works for HCL native syntax. JSON syntax is not supported
-	// and returns tflint.ErrFixNotSupported.
+	// In JSON syntax, the property is removed including the comma.
 	RemoveAttribute(*hcl.Attribute) error
 
 	// RemoveBlock removes the given block from the source code.
 	// The difference from Remove is that it removes the block
 	// and the associated newlines, indentations, and comments.
-	// This only works for HCL native syntax. JSON syntax is not supported
-	// and returns tflint.ErrFixNotSupported.
+	// In JSON syntax, the property or the array element is removed including the comma,
+	// and the parent objects for the block type and labels are also removed if they become empty.
 	RemoveBlock(*hcl.Block) error
 
 	// RemoveExtBlock removes the given block from the source code.
 	// This is similar to RemoveBlock, but it works for hclext.Block.
 	RemoveExtBlock(*hclext.Block) error
 
+	// SetAttribute sets the value of the attribute with the given name in the given block.
+	// If the attribute exists, its expression is replaced with the given value.
+	// Otherwise, a new attribute is inserted after the last attribute in the block.
+	// The value is a text of an HCL expression, like the result of ValueText.
+	// Continuation lines of a multi-line value are indented to match the line it is set on.
+	// In JSON syntax, literal values are converted to JSON values, and other expressions
+	// are converted to template strings like "${var.foo}".
+	//
+	// ```
+	// fixer.SetAttribute(resource, "tags", "{}")
+	// ```
+	SetAttribute(block *hclext.Block, name string, value string) error
+
+	// AppendBlock appends a nested block rendered from the given HCL snippet
+	// to the end of the given block. The snippet is indented to match the given block.
+	// In JSON syntax, the snippet must contain a single block, and it is converted to
+	// a JSON object in the same way as SetAttribute. If there are already blocks of the type,
+	// the block is appended to the array of them.
+	//
+	// ```
+	// fixer.AppendBlock(resource, `lifecycle {
+	//   create_before_destroy = true
+	// }`)
+	// ```
+	AppendBlock(block *hclext.Block, snippet string) error
+
+	// SetObjectItem sets the value of the given key in the given object expression.
+	// If the key exists, its value is replaced with the given value.
+	// Otherwise, a new item is inserted at the end of the object.
+	// The value is a text of an HCL expression, like the result of ValueText.
+	// Continuation lines of a multi-line value are indented to match the line it is set on.
+	// In JSON syntax, literal values are converted to JSON values, and other expressions
+	// are converted to template strings like "${var.foo}".
+	//
+	// ```
+	// fixer.SetObjectItem(resource.Body.Attributes["tags"].Expr, "Environment", `"production"`)
+	// ```
+	SetObjectItem(expr hcl.Expression, key string, value string) error
+
+	// Rename renames the object of the given address declared in the current module,
+	// and rewrites all references to it in the module. Renaming resources and module calls
+	// also adds a moved block after the declaration to avoid destroying existing objects.
+	//
+	// The address is one of `<TYPE>.<NAME>`, `data.<TYPE>.<NAME>`, `var.<NAME>`,
+	// `local.<NAME>`, `output.<NAME>` and `module.<NAME>`. Note that references outside
+	// the module, such as module arguments and outputs in the parent module, are not rewritten.
+	// If the module contains files in JSON syntax, it returns tflint.ErrFixNotSupported.
+	//
+	// ```
+	// fixer.Rename("aws_s3_bucket.Foo", "foo")
+	// ```
+	Rename(addr string, name string) error
+
 	// TextAt returns a text node at the given range.
 	// This is expected to be passed as an argument to ReplaceText.
 	// Note this doesn't take into account the changes made by the fixer in a rule.
@@ -332,6 +481,10 @@ type Rule interface {
 	// This value is never referenced by the SDK and can be used for your custom ruleset.
 	Metadata() interface{}
 
+	// FormatFixes indicates whether files changed by the rule's fixes are formatted.
+	// How they are formatted depends on the FormatMode in the config.
+	FormatFixes() bool
+
 	// Check is the entrypoint of the rule. You can fetch Terraform configurations and send issues via Runner.
 	Check(Runner) error
 
@@ -25,3 +25,13 @@ func (s Severity) String() string {
 
 	return "Unknown"
 }
+
+// Fix is a named alternative fix for an issue.
+// See Runner.EmitIssueWithFixes for details.
+type Fix struct {
+	// Name is a short description of the fix shown to users,
+	// like "Remove the attribute".
+	Name string
+	// Func rewrites the source code using the passed Fixer.
+	Func func(f Fixer) error
+}
@@ -1,6 +1,9 @@
 package tflint
 
-import "github.com/zclconf/go-cty/cty"
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+)
 
 // ModuleCtxType represents target module.
 //
@@ -21,6 +24,7 @@ type ExpandMode int32
 
 const (
 	// ExpandModeExpand is the mode for expanding blocks based on the meta-arguments. The default is this behavior.
+	// Expanded blocks have the instance key and each.value/count.index in hclext.Block.Instance.
 	ExpandModeExpand ExpandMode = iota
 	// ExpandModeNone is the mode that does not expand blocks.
 	ExpandModeNone
@@ -48,3 +52,11 @@ type EvaluateExprOption struct {
 	// Set the scope of the module to evaluate.
 	ModuleCtx ModuleCtxType
 }
+
+// EvaluateExprRequest is a request for EvaluateExprs.
+// Expr, Target, and Option are the same as the arguments of EvaluateExpr.
+type EvaluateExprRequest struct {
+	Expr   hcl.Expression
+	Target interface{}
+	Option *EvaluateExprOption
+}
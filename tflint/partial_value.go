@@ -0,0 +1,83 @@
+package tflint
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/terraform/lang/marks"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// PartialValue is a special target of EvaluateExpr that receives the evaluated value as it is,
+// even if it is partially unknown, sensitive, or ephemeral. Unlike other targets, no errors
+// such as ErrUnknownValue and ErrSensitive are returned for these values.
+//
+// This is useful to check the known parts of a value, like a map of tags that contains
+// some unknown elements:
+//
+//	var tags tflint.PartialValue
+//	runner.EvaluateExpr(expr, &tags, nil)
+//
+//	for it := tags.Value.ElementIterator(); it.Next(); {
+//		k, v := it.Element()
+//		if !v.IsKnown() {
+//			continue
+//		}
+//		// Test the known element
+//	}
+type PartialValue struct {
+	// Value is the evaluated value without marks.
+	// The marks can be found by SensitivePaths and EphemeralPaths,
+	// or you can restore the marked value with MarkedValue.
+	Value cty.Value
+
+	// UnknownPaths are the paths to unknown values in Value.
+	// If the whole value is unknown, it has an empty path.
+	UnknownPaths []cty.Path
+	// SensitivePaths are the paths to sensitive values in Value.
+	SensitivePaths []cty.Path
+	// EphemeralPaths are the paths to ephemeral values in Value.
+	EphemeralPaths []cty.Path
+
+	pvm []cty.PathValueMarks
+}
+
+// NewPartialValue returns a new PartialValue from the given value.
+// The given value can have marks.
+func NewPartialValue(val cty.Value) *PartialValue {
+	unmarked, pvm := val.UnmarkDeepWithPaths()
+
+	ret := &PartialValue{
+		Value:          unmarked,
+		UnknownPaths:   []cty.Path{},
+		SensitivePaths: []cty.Path{},
+		EphemeralPaths: []cty.Path{},
+		pvm:            pvm,
+	}
+
+	for _, m := range pvm {
+		if _, exists := m.Marks[marks.Sensitive]; exists {
+			ret.SensitivePaths = append(ret.SensitivePaths, m.Path.Copy())
+		}
+		if _, exists := m.Marks[marks.Ephemeral]; exists {
+			ret.EphemeralPaths = append(ret.EphemeralPaths, m.Path.Copy())
+		}
+	}
+
+	cty.Walk(unmarked, func(path cty.Path, v cty.Value) (bool, error) {
+		if !v.IsKnown() {
+			ret.UnknownPaths = append(ret.UnknownPaths, path.Copy())
+			return false, nil
+		}
+		return true, nil
+	})
+
+	return ret
+}
+
+// MarkedValue returns the value with marks attached per path.
+func (v PartialValue) MarkedValue() cty.Value {
+	return v.Value.MarkWithPaths(v.pvm)
+}
+
+// IsWhollyKnown returns true if the value has no unknown values.
+func (v PartialValue) IsWhollyKnown() bool {
+	return len(v.UnknownPaths) == 0
+}
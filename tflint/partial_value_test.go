@@ -0,0 +1,78 @@
+package tflint
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/terraform-linters/tflint-plugin-sdk/terraform/lang/marks"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestNewPartialValue(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     cty.Value
+		unknown   []cty.Path
+		sensitive []cty.Path
+		ephemeral []cty.Path
+	}{
+		{
+			name:      "known value",
+			value:     cty.StringVal("foo"),
+			unknown:   []cty.Path{},
+			sensitive: []cty.Path{},
+			ephemeral: []cty.Path{},
+		},
+		{
+			name:      "wholly unknown",
+			value:     cty.UnknownVal(cty.Map(cty.String)),
+			unknown:   []cty.Path{{}},
+			sensitive: []cty.Path{},
+			ephemeral: []cty.Path{},
+		},
+		{
+			name: "nested values",
+			value: cty.ObjectVal(map[string]cty.Value{
+				"tags": cty.MapVal(map[string]cty.Value{
+					"Name":  cty.StringVal("foo"),
+					"Env":   cty.UnknownVal(cty.String),
+					"Owner": cty.StringVal("secret").Mark(marks.Sensitive),
+				}),
+				"token": cty.StringVal("token").Mark(marks.Ephemeral),
+				"ids":   cty.ListVal([]cty.Value{cty.StringVal("a"), cty.UnknownVal(cty.String)}),
+			}),
+			unknown: []cty.Path{
+				cty.GetAttrPath("ids").IndexInt(1),
+				cty.GetAttrPath("tags").IndexString("Env"),
+			},
+			sensitive: []cty.Path{cty.GetAttrPath("tags").IndexString("Owner")},
+			ephemeral: []cty.Path{cty.GetAttrPath("token")},
+		},
+	}
+
+	opts := cmp.Options{
+		cmp.Comparer(func(x, y cty.Path) bool { return x.Equals(y) }),
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := NewPartialValue(test.value)
+
+			if diff := cmp.Diff(test.unknown, got.UnknownPaths, opts); diff != "" {
+				t.Errorf("unknown paths: %s", diff)
+			}
+			if diff := cmp.Diff(test.sensitive, got.SensitivePaths, opts); diff != "" {
+				t.Errorf("sensitive paths: %s", diff)
+			}
+			if diff := cmp.Diff(test.ephemeral, got.EphemeralPaths, opts); diff != "" {
+				t.Errorf("ephemeral paths: %s", diff)
+			}
+			if got.IsWhollyKnown() != (len(test.unknown) == 0) {
+				t.Errorf("IsWhollyKnown() returns %t", got.IsWhollyKnown())
+			}
+			if !got.MarkedValue().RawEquals(test.value) {
+				t.Errorf("MarkedValue() returns %#v, but want %#v", got.MarkedValue(), test.value)
+			}
+		})
+	}
+}
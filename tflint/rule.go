@@ -16,6 +16,13 @@ func (r *DefaultRule) Metadata() interface{} {
 	return nil
 }
 
+// FormatFixes indicates whether files changed by the rule's fixes are formatted.
+// The default is true. Return false if the fixes should not cause any changes
+// other than their own, even if the file is not formatted.
+func (r *DefaultRule) FormatFixes() bool {
+	return true
+}
+
 func (r *DefaultRule) mustEmbedDefaultRule() {}
 
 var _ Rule = &embedDefaultRule{}
@@ -7,3 +7,10 @@ type TextNode struct {
 	Bytes []byte
 	Range hcl.Range
 }
+
+// TextEdit represents a replacement of a range in the source code with a new text.
+// An empty range means an insertion, and an empty text means a removal.
+type TextEdit struct {
+	Range   hcl.Range
+	NewText string
+}
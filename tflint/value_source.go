@@ -0,0 +1,41 @@
+package tflint
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/terraform/addrs"
+)
+
+// ValueSource is a node of the tree returned by Runner.TraceValue.
+// It represents an expression through which a value flows.
+type ValueSource struct {
+	// Ref is the reference by which this source was reached, like `local.name` or `var.env`.
+	// This is nil for the root of the tree.
+	Ref *addrs.Reference
+
+	// Expr is the expression of the source.
+	// For local values, this is the value expression, and for input variables,
+	// this is the `default` expression. It is nil if the variable has no default.
+	Expr hcl.Expression
+
+	// DeclRange is the declaration range of the referenced local value or input variable.
+	// This is empty for the root of the tree.
+	DeclRange hcl.Range
+
+	// Sources are the values referenced from Expr.
+	Sources []*ValueSource
+}
+
+// Origins returns the leaves of the tree, i.e. the sources that do not refer to
+// other local values or input variables. These are usually literals in a `locals`
+// block or a variable default, or input variables without defaults.
+func (s *ValueSource) Origins() []*ValueSource {
+	if len(s.Sources) == 0 {
+		return []*ValueSource{s}
+	}
+
+	ret := []*ValueSource{}
+	for _, source := range s.Sources {
+		ret = append(ret, source.Origins()...)
+	}
+	return ret
+}
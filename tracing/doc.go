@@ -0,0 +1,14 @@
+// Package tracing provides lightweight tracing spans for measuring the performance of plugins.
+//
+// The model follows OpenTelemetry. A span has a name, a trace ID shared by all spans of a trace,
+// its own span ID, the parent span ID, timings, and attributes. Spans are propagated across
+// process boundaries in the W3C Trace Context format (the "traceparent" header), so spans of
+// TFLint and plugins are put together into a single trace.
+//
+// Spans are recorded only when an exporter is set. If the TFLINT_TRACE_FILE environment variable
+// is set, spans are appended to the file in JSON Lines format. Since both TFLint and plugins
+// inherit the environment variable, spans of all processes are written to the same file.
+// You can also set your own exporter with SetExporter, e.g. MemoryExporter for testing.
+//
+// The SDK records spans for each Check, each rule, and each request from the plugin to TFLint.
+package tracing
@@ -0,0 +1,79 @@
+package tracing
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+)
+
+// FileExporter is an exporter that appends spans to a file in JSON Lines format.
+type FileExporter struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+var _ Exporter = &FileExporter{}
+
+// NewFileExporter opens the file in append mode and returns an exporter.
+// Each span is written in a single write, so multiple processes can share the file.
+func NewFileExporter(path string) (*FileExporter, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileExporter{file: file}, nil
+}
+
+// ExportSpan writes the span as a line of JSON.
+func (e *FileExporter) ExportSpan(span *SpanData) {
+	out, err := json.Marshal(span)
+	if err != nil {
+		logger.Error("failed to encode a span", "name", span.Name, "err", err)
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, err := e.file.Write(append(out, '\n')); err != nil {
+		logger.Error("failed to write a span", "name", span.Name, "err", err)
+	}
+}
+
+// Close closes the file.
+func (e *FileExporter) Close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.file.Close()
+}
+
+// MemoryExporter is an exporter that keeps spans in memory.
+// This is useful as a stand-in for a collector in tests.
+type MemoryExporter struct {
+	mu    sync.Mutex
+	spans []*SpanData
+}
+
+var _ Exporter = &MemoryExporter{}
+
+// ExportSpan stores the span.
+func (e *MemoryExporter) ExportSpan(span *SpanData) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spans = append(e.spans, span)
+}
+
+// Spans returns stored spans in order of completion.
+func (e *MemoryExporter) Spans() []*SpanData {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]*SpanData{}, e.spans...)
+}
+
+// Reset removes all stored spans.
+func (e *MemoryExporter) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spans = nil
+}
@@ -0,0 +1,264 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+)
+
+// TraceID is a unique identifier of a trace.
+type TraceID [16]byte
+
+// IsValid returns true if the ID is not all zeros.
+func (t TraceID) IsValid() bool {
+	return t != TraceID{}
+}
+
+// String returns the hex representation of the ID.
+func (t TraceID) String() string {
+	return hex.EncodeToString(t[:])
+}
+
+// SpanID is a unique identifier of a span in a trace.
+type SpanID [8]byte
+
+// IsValid returns true if the ID is not all zeros.
+func (s SpanID) IsValid() bool {
+	return s != SpanID{}
+}
+
+// String returns the hex representation of the ID.
+func (s SpanID) String() string {
+	return hex.EncodeToString(s[:])
+}
+
+// SpanContext is the identity of a span that is propagated to child spans.
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+}
+
+// IsValid returns true if both the trace ID and the span ID are valid.
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID.IsValid() && sc.SpanID.IsValid()
+}
+
+// TraceParent returns the span context in the W3C Trace Context "traceparent" format.
+func (sc SpanContext) TraceParent() string {
+	return fmt.Sprintf("00-%s-%s-01", sc.TraceID, sc.SpanID)
+}
+
+// ParseTraceParent parses the W3C Trace Context "traceparent" format.
+func ParseTraceParent(s string) (SpanContext, error) {
+	parts := strings.Split(s, "-")
+	if len(parts) != 4 || len(parts[0]) != 2 || len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return SpanContext{}, fmt.Errorf(`invalid traceparent "%s"`, s)
+	}
+	if parts[0] == "ff" {
+		return SpanContext{}, fmt.Errorf(`invalid traceparent version "%s"`, parts[0])
+	}
+
+	var sc SpanContext
+	if _, err := hex.Decode(sc.TraceID[:], []byte(parts[1])); err != nil {
+		return SpanContext{}, fmt.Errorf(`invalid trace ID "%s": %w`, parts[1], err)
+	}
+	if _, err := hex.Decode(sc.SpanID[:], []byte(parts[2])); err != nil {
+		return SpanContext{}, fmt.Errorf(`invalid span ID "%s": %w`, parts[2], err)
+	}
+	if !sc.IsValid() {
+		return SpanContext{}, fmt.Errorf(`invalid traceparent "%s"`, s)
+	}
+	return sc, nil
+}
+
+// SpanData is a finished span passed to the exporter.
+type SpanData struct {
+	Name         string                 `json:"name"`
+	TraceID      string                 `json:"trace_id"`
+	SpanID       string                 `json:"span_id"`
+	ParentSpanID string                 `json:"parent_span_id,omitempty"`
+	StartTime    time.Time              `json:"start_time"`
+	EndTime      time.Time              `json:"end_time"`
+	Duration     time.Duration          `json:"duration_ns"`
+	Attributes   map[string]interface{} `json:"attributes,omitempty"`
+	Error        string                 `json:"error,omitempty"`
+}
+
+// Exporter receives finished spans. Implementations must be safe for concurrent use.
+type Exporter interface {
+	ExportSpan(*SpanData)
+}
+
+var (
+	exporterMu sync.RWMutex
+	exporter   Exporter
+)
+
+// Use the init process to set the file exporter from the environment variable.
+func init() {
+	path := os.Getenv("TFLINT_TRACE_FILE")
+	if path == "" {
+		return
+	}
+
+	fileExporter, err := NewFileExporter(path)
+	if err != nil {
+		logger.Error("failed to open the trace file", "path", path, "err", err)
+		return
+	}
+	SetExporter(fileExporter)
+}
+
+// SetExporter sets the global exporter. If nil, spans are no longer recorded.
+func SetExporter(e Exporter) {
+	exporterMu.Lock()
+	defer exporterMu.Unlock()
+	exporter = e
+}
+
+// Enabled returns true if spans are recorded.
+func Enabled() bool {
+	return currentExporter() != nil
+}
+
+func currentExporter() Exporter {
+	exporterMu.RLock()
+	defer exporterMu.RUnlock()
+	return exporter
+}
+
+// Span represents a single operation in a trace.
+// All methods are safe to call on a nil span, which is returned when tracing is disabled.
+type Span struct {
+	name     string
+	sc       SpanContext
+	parent   SpanID
+	start    time.Time
+	exporter Exporter
+
+	mu    sync.Mutex
+	attrs map[string]interface{}
+	err   error
+	ended bool
+}
+
+type spanKey struct{}
+type remoteSpanContextKey struct{}
+
+// Start starts a new span that is a child of the span in the context.
+// If the context has no span, the span becomes a child of the remote span context,
+// or a root span of a new trace. The returned context has the new span.
+//
+// If tracing is disabled, it returns the passed context and a nil span.
+// In this case, the remote span context is kept in the context and can be propagated.
+func Start(ctx context.Context, name string) (context.Context, *Span) {
+	e := currentExporter()
+	if e == nil {
+		return ctx, nil
+	}
+
+	span := &Span{name: name, start: time.Now(), exporter: e}
+	if parent := SpanContextFromContext(ctx); parent.IsValid() {
+		span.sc.TraceID = parent.TraceID
+		span.parent = parent.SpanID
+	} else {
+		_, _ = rand.Read(span.sc.TraceID[:])
+	}
+	_, _ = rand.Read(span.sc.SpanID[:])
+
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// SpanFromContext returns the span in the context, or nil if not found.
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+// SpanContextFromContext returns the span context of the span in the context.
+// If the context has no span, it returns the remote span context.
+func SpanContextFromContext(ctx context.Context) SpanContext {
+	if span := SpanFromContext(ctx); span != nil {
+		return span.sc
+	}
+	sc, _ := ctx.Value(remoteSpanContextKey{}).(SpanContext)
+	return sc
+}
+
+// ContextWithRemoteSpanContext returns a context with the span context propagated from another process.
+func ContextWithRemoteSpanContext(ctx context.Context, sc SpanContext) context.Context {
+	return context.WithValue(ctx, remoteSpanContextKey{}, sc)
+}
+
+// SpanContext returns the span context of the span.
+func (s *Span) SpanContext() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.sc
+}
+
+// SetAttribute sets an attribute of the span. The value should be encodable as JSON.
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.attrs == nil {
+		s.attrs = map[string]interface{}{}
+	}
+	s.attrs[key] = value
+}
+
+// SetError records the error of the operation. It does nothing if err is nil.
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+// End finishes the span and exports it. Calling End more than once has no effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	end := time.Now()
+
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+
+	data := &SpanData{
+		Name:       s.name,
+		TraceID:    s.sc.TraceID.String(),
+		SpanID:     s.sc.SpanID.String(),
+		StartTime:  s.start,
+		EndTime:    end,
+		Duration:   end.Sub(s.start),
+		Attributes: s.attrs,
+	}
+	if s.parent.IsValid() {
+		data.ParentSpanID = s.parent.String()
+	}
+	if s.err != nil {
+		data.Error = s.err.Error()
+	}
+	s.mu.Unlock()
+
+	s.exporter.ExportSpan(data)
+}
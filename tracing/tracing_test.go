@@ -0,0 +1,195 @@
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseTraceParent(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  SpanContext
+		err   string
+	}{
+		{
+			name:  "valid",
+			input: "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+			want: SpanContext{
+				TraceID: TraceID{0x0a, 0xf7, 0x65, 0x19, 0x16, 0xcd, 0x43, 0xdd, 0x84, 0x48, 0xeb, 0x21, 0x1c, 0x80, 0x31, 0x9c},
+				SpanID:  SpanID{0xb7, 0xad, 0x6b, 0x71, 0x69, 0x20, 0x33, 0x31},
+			},
+		},
+		{
+			name:  "invalid format",
+			input: "00-0af7651916cd43dd8448eb211c80319c-01",
+			err:   `invalid traceparent "00-0af7651916cd43dd8448eb211c80319c-01"`,
+		},
+		{
+			name:  "invalid version",
+			input: "ff-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+			err:   `invalid traceparent version "ff"`,
+		},
+		{
+			name:  "invalid trace ID",
+			input: "00-0af7651916cd43dd8448eb211c80319z-b7ad6b7169203331-01",
+			err:   `invalid trace ID "0af7651916cd43dd8448eb211c80319z": encoding/hex: invalid byte: U+007A 'z'`,
+		},
+		{
+			name:  "zero IDs",
+			input: "00-00000000000000000000000000000000-0000000000000000-01",
+			err:   `invalid traceparent "00-00000000000000000000000000000000-0000000000000000-01"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseTraceParent(test.input)
+			if err != nil {
+				if err.Error() != test.err {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if test.err != "" {
+				t.Fatalf("expected error %q, but got nothing", test.err)
+			}
+
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(test.input, got.TraceParent()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestStart(t *testing.T) {
+	exporter := &MemoryExporter{}
+	SetExporter(exporter)
+	defer SetExporter(nil)
+
+	remote := SpanContext{TraceID: TraceID{1}, SpanID: SpanID{2}}
+	ctx := ContextWithRemoteSpanContext(context.Background(), remote)
+
+	ctx, parent := Start(ctx, "parent")
+	_, child := Start(ctx, "child")
+	child.SetAttribute("rule", "aws_instance_invalid_type")
+	child.SetError(errors.New("unexpected error"))
+	child.End()
+	child.End()
+	parent.End()
+
+	spans := exporter.Spans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, but got %d", len(spans))
+	}
+
+	want := []*SpanData{
+		{
+			Name:         "child",
+			TraceID:      remote.TraceID.String(),
+			SpanID:       child.SpanContext().SpanID.String(),
+			ParentSpanID: parent.SpanContext().SpanID.String(),
+			Attributes:   map[string]interface{}{"rule": "aws_instance_invalid_type"},
+			Error:        "unexpected error",
+		},
+		{
+			Name:         "parent",
+			TraceID:      remote.TraceID.String(),
+			SpanID:       parent.SpanContext().SpanID.String(),
+			ParentSpanID: remote.SpanID.String(),
+		},
+	}
+	for _, span := range spans {
+		if span.EndTime.Before(span.StartTime) || span.Duration != span.EndTime.Sub(span.StartTime) {
+			t.Errorf("invalid timings: %#v", span)
+		}
+		span.StartTime, span.EndTime, span.Duration = want[0].StartTime, want[0].EndTime, 0
+	}
+	if diff := cmp.Diff(want, spans); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestStart_root(t *testing.T) {
+	SetExporter(&MemoryExporter{})
+	defer SetExporter(nil)
+
+	_, span := Start(context.Background(), "root")
+	if !span.SpanContext().IsValid() {
+		t.Errorf("root span should have a valid span context")
+	}
+}
+
+func TestStart_disabled(t *testing.T) {
+	remote := SpanContext{TraceID: TraceID{1}, SpanID: SpanID{2}}
+	ctx := ContextWithRemoteSpanContext(context.Background(), remote)
+
+	got, span := Start(ctx, "disabled")
+	if span != nil {
+		t.Fatalf("span should be nil when tracing is disabled")
+	}
+	// Methods on a nil span are no-op
+	span.SetAttribute("key", "value")
+	span.SetError(errors.New("unexpected error"))
+	span.End()
+
+	if SpanContextFromContext(got) != remote {
+		t.Errorf("remote span context should be kept")
+	}
+}
+
+func TestFileExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	exporter, err := NewFileExporter(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetExporter(exporter)
+	defer SetExporter(nil)
+
+	ctx, parent := Start(context.Background(), "parent")
+	_, child := Start(ctx, "child")
+	child.SetAttribute("files", 2)
+	child.End()
+	parent.End()
+	if err := exporter.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	got := []map[string]interface{}{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var span map[string]interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &span); err != nil {
+			t.Fatalf("failed to parse a line: %s", err)
+		}
+		for _, key := range []string{"trace_id", "span_id", "parent_span_id", "start_time", "end_time", "duration_ns"} {
+			delete(span, key)
+		}
+		got = append(got, span)
+	}
+
+	want := []map[string]interface{}{
+		{"name": "child", "attributes": map[string]interface{}{"files": float64(2)}},
+		{"name": "parent"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+}